@@ -0,0 +1,263 @@
+package collection
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+var (
+	ErrQueueClosed   = fmt.Errorf("queue closed")
+	ErrDrainToItself = fmt.Errorf("queue can not be drained to itself")
+)
+
+// BlockingQueue is a FIFO queue safe for concurrent use where producers wait
+// while the queue is full and consumers wait while it is empty. Waiters are
+// woken by closing a channel that is then replaced, so they can wait on a
+// context at the same time.
+type BlockingQueue[E any] struct {
+	mu       sync.Mutex
+	items    *Queue[E]
+	capacity int
+	notEmpty chan struct{}
+	notFull  chan struct{}
+	closed   bool
+	equal    EqualFunc[E]
+}
+
+// NewBlockingQueue creates a queue holding at most capacity items, a
+// capacity lower than one makes the queue unbounded. It starts with the
+// items given by WithItems, even when they are more than capacity.
+func NewBlockingQueue[E any](capacity int, opts ...Option[E]) *BlockingQueue[E] {
+	o := newOptions(opts...)
+	return &BlockingQueue[E]{
+		items:    NewQueue(o.items...),
+		capacity: capacity,
+		notEmpty: make(chan struct{}),
+		notFull:  make(chan struct{}),
+		equal:    o.equal,
+	}
+}
+
+func (q *BlockingQueue[E]) eq() EqualFunc[E] {
+	if q.equal == nil {
+		return DefaultEqual[E]()
+	}
+	return q.equal
+}
+
+func (q *BlockingQueue[E]) full() bool {
+	return q.capacity > 0 && q.items.Size() >= q.capacity
+}
+
+// signal wakes the goroutines waiting on ch and returns the channel the next
+// ones have to wait on, the lock must be held.
+func (q *BlockingQueue[E]) signal(ch chan struct{}) chan struct{} {
+	if q.closed {
+		return ch
+	}
+	close(ch)
+	return make(chan struct{})
+}
+
+// Put adds an item at the back of the queue waiting while it is full, but
+// if ctx is done first well this method return ctx.Err() and if the queue
+// is closed ErrQueueClosed error.
+func (q *BlockingQueue[E]) Put(ctx context.Context, item E) error {
+	for {
+		q.mu.Lock()
+		if q.closed {
+			q.mu.Unlock()
+			return ErrQueueClosed
+		}
+		if !q.full() {
+			q.items.Enqueue(item)
+			q.notEmpty = q.signal(q.notEmpty)
+			q.mu.Unlock()
+			return nil
+		}
+		wait := q.notFull
+		q.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-wait:
+		}
+	}
+}
+
+// Take get and remove the item at the front of the queue waiting while it
+// is empty, but if ctx is done first well this method return ctx.Err() and
+// if the queue is closed and empty ErrQueueClosed error. The items left in
+// a closed queue can still be taken.
+func (q *BlockingQueue[E]) Take(ctx context.Context) (E, error) {
+	for {
+		q.mu.Lock()
+		if !q.items.Empty() {
+			item, _ := q.items.Dequeue()
+			q.notFull = q.signal(q.notFull)
+			q.mu.Unlock()
+			return item, nil
+		}
+		if q.closed {
+			q.mu.Unlock()
+			return *new(E), ErrQueueClosed
+		}
+		wait := q.notEmpty
+		q.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return *new(E), ctx.Err()
+		case <-wait:
+		}
+	}
+}
+
+// Offer is like Put but it gives up after timeout returning context.DeadlineExceeded.
+func (q *BlockingQueue[E]) Offer(item E, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return q.Put(ctx, item)
+}
+
+// Poll is like Take but it gives up after timeout returning context.DeadlineExceeded.
+func (q *BlockingQueue[E]) Poll(timeout time.Duration) (E, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return q.Take(ctx)
+}
+
+// DrainTo moves at most max items from the front of the queue to c and
+// returns how many were moved, a negative max moves them all. The items are
+// taken without waiting and pushed to c once the lock of the queue is
+// released, so c may block, for example a full BlockingQueue, without
+// blocking this queue. If c is the queue itself well this method return
+// ErrDrainToItself error. When c is a BlockingQueue closed before all the
+// items are moved, the items left are put back at the front of this queue
+// and ErrQueueClosed is returned along with the count of the moved ones.
+func (q *BlockingQueue[E]) DrainTo(c Collection[E], max int) (int, error) {
+	if any(c) == any(q) {
+		return 0, ErrDrainToItself
+	}
+	q.mu.Lock()
+	var items []E
+	for !q.items.Empty() && (max < 0 || len(items) < max) {
+		item, _ := q.items.Dequeue()
+		items = append(items, item)
+	}
+	if len(items) > 0 {
+		q.notFull = q.signal(q.notFull)
+	}
+	q.mu.Unlock()
+
+	target, ok := c.(*BlockingQueue[E])
+	if !ok {
+		for _, item := range items {
+			c.Push(item)
+		}
+		return len(items), nil
+	}
+	for i, item := range items {
+		if err := target.Put(context.Background(), item); err != nil {
+			q.requeue(items[i:])
+			return i, err
+		}
+	}
+	return len(items), nil
+}
+
+// requeue puts items back at the front of the queue, ahead of the ones
+// added meanwhile, even when this exceeds its capacity.
+func (q *BlockingQueue[E]) requeue(items []E) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.items.load(slices.AppendSeq(items, q.items.All()))
+	q.notEmpty = q.signal(q.notEmpty)
+}
+
+// Close wakes all the waiting goroutines, afterwards Put fails with
+// ErrQueueClosed while Take keeps returning the items left in the queue.
+func (q *BlockingQueue[E]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return
+	}
+	q.closed = true
+	close(q.notEmpty)
+	close(q.notFull)
+}
+
+// Closed reports whether Close has been called.
+func (q *BlockingQueue[E]) Closed() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.closed
+}
+
+// Iterator returns an iterator over a snapshot of the items in FIFO order,
+// its Remove returns ErrUnsupportedOperation.
+func (q *BlockingQueue[E]) Iterator() Iterator[E] {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return newSnapshotIterator(slices.Collect(q.items.All()))
+}
+
+func (q *BlockingQueue[E]) Empty() bool {
+	return q.Size() == 0
+}
+
+func (q *BlockingQueue[E]) Size() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.items.Size()
+}
+
+// Push is Put without a deadline, the item is dropped when the queue is closed.
+func (q *BlockingQueue[E]) Push(item E) {
+	_ = q.Put(context.Background(), item)
+}
+
+func (q *BlockingQueue[E]) Contains(item E) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	eq := q.eq()
+	for x := range q.items.All() {
+		if eq(x, item) {
+			return true
+		}
+	}
+	return false
+}
+
+// Delete removes the first occurrence of item from the queue in linear time.
+func (q *BlockingQueue[E]) Delete(item E) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	eq := q.eq()
+	found := false
+	items := NewQueue[E]()
+	for x := range q.items.All() {
+		if !found && eq(x, item) {
+			found = true
+			continue
+		}
+		items.Enqueue(x)
+	}
+	if !found {
+		return ErrItemNotFound{item}
+	}
+	q.items = items
+	q.notFull = q.signal(q.notFull)
+	return nil
+}
+
+func (q *BlockingQueue[E]) String() string {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.items.String()
+}
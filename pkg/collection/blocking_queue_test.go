@@ -0,0 +1,315 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBlockingQueue_Offer(t *testing.T) {
+	useCases := []struct {
+		description string
+		queue       *BlockingQueue[int]
+		err         error
+		size        int
+	}{
+		{description: "offer to empty queue", queue: NewBlockingQueue[int](2), err: nil, size: 1},
+		{description: "offer to full queue", queue: fullBlockingQueue(2), err: context.DeadlineExceeded, size: 2},
+		{description: "offer to unbounded queue", queue: fullBlockingQueue(0), err: nil, size: 1},
+		{description: "offer to closed queue", queue: closedBlockingQueue(), err: ErrQueueClosed, size: 0},
+	}
+
+	for _, tt := range useCases {
+		err := tt.queue.Offer(1, 10*time.Millisecond)
+		if err != tt.err {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.err, err)
+		}
+		if tt.queue.Size() != tt.size {
+			t.Errorf("test: %s size want %v got %v", tt.description, tt.size, tt.queue.Size())
+		}
+	}
+}
+
+func TestBlockingQueue_Poll(t *testing.T) {
+	useCases := []struct {
+		description string
+		queue       *BlockingQueue[int]
+		want        int
+		err         error
+	}{
+		{description: "poll from empty queue", queue: NewBlockingQueue[int](2), want: 0, err: context.DeadlineExceeded},
+		{description: "poll from full queue", queue: fullBlockingQueue(2), want: 0, err: nil},
+		{description: "poll from closed queue", queue: closedBlockingQueue(), want: 0, err: ErrQueueClosed},
+	}
+
+	for _, tt := range useCases {
+		item, err := tt.queue.Poll(10 * time.Millisecond)
+		if item != tt.want || err != tt.err {
+			t.Errorf("test: %s want (%v, %v) got (%v, %v)", tt.description, tt.want, tt.err, item, err)
+		}
+	}
+}
+
+func TestBlockingQueue_Cancel(t *testing.T) {
+	queue := fullBlockingQueue(1)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- queue.Put(ctx, 1)
+	}()
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("test: put cancelled want %v got %v", context.Canceled, err)
+	}
+
+	queue = NewBlockingQueue[int](1)
+	ctx, cancel = context.WithCancel(context.Background())
+	go func() {
+		_, err := queue.Take(ctx)
+		done <- err
+	}()
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("test: take cancelled want %v got %v", context.Canceled, err)
+	}
+}
+
+func TestBlockingQueue_Close(t *testing.T) {
+	full, empty := fullBlockingQueue(1), NewBlockingQueue[int](1)
+	errs := make(chan error, 4)
+	for i := 0; i < 2; i++ {
+		go func() {
+			errs <- full.Put(context.Background(), 1)
+		}()
+		go func() {
+			_, err := empty.Take(context.Background())
+			errs <- err
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	full.Close()
+	empty.Close()
+	for i := 0; i < 4; i++ {
+		if err := <-errs; err != ErrQueueClosed {
+			t.Errorf("test: waiter woken by close want %v got %v", ErrQueueClosed, err)
+		}
+	}
+
+	item, err := full.Take(context.Background())
+	if item != 0 || err != nil {
+		t.Errorf("test: take left item from closed queue want (%v, %v) got (%v, %v)", 0, nil, item, err)
+	}
+	full.Close()
+	if !full.Closed() || !full.Empty() {
+		t.Errorf("test: closed and empty want %v got (%v, %v)", true, full.Closed(), full.Empty())
+	}
+}
+
+func TestBlockingQueue_DrainTo(t *testing.T) {
+	useCases := []struct {
+		description string
+		max         int
+		want        int
+		left        int
+	}{
+		{description: "drain some items", max: 2, want: 2, left: 3},
+		{description: "drain more than available", max: 10, want: 5, left: 0},
+		{description: "drain all items", max: -1, want: 5, left: 0},
+		{description: "drain nothing", max: 0, want: 0, left: 5},
+	}
+
+	for _, tt := range useCases {
+		queue := fullBlockingQueue(5)
+		target := NewSlice[int]()
+		n, err := queue.DrainTo(target, tt.max)
+		if err != nil || n != tt.want || target.Size() != tt.want || queue.Size() != tt.left {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.want, n)
+		}
+		for i := 0; i < target.Size(); i++ {
+			if item, _ := target.GetAt(i); item != i {
+				t.Errorf("test: %s item at %d want %v got %v", tt.description, i, i, item)
+			}
+		}
+	}
+}
+
+func TestBlockingQueue_DrainToItself(t *testing.T) {
+	queue := fullBlockingQueue(3)
+	n, err := queue.DrainTo(queue, -1)
+	if n != 0 || err != ErrDrainToItself || queue.Size() != 3 {
+		t.Errorf("test: drain to itself want (%v, %v) got (%v, %v)", 0, ErrDrainToItself, n, err)
+	}
+}
+
+func TestBlockingQueue_DrainToBlockingQueue(t *testing.T) {
+	source := fullBlockingQueue(10)
+	target := NewBlockingQueue[int](2)
+
+	drained := make(chan int)
+	go func() {
+		n, _ := source.DrainTo(target, -1)
+		drained <- n
+	}()
+
+	// the drain waits on the full target, the source must stay usable
+	if err := source.Offer(42, time.Second); err != nil {
+		t.Errorf("test: put into the source while draining want %v got %v", nil, err)
+	}
+
+	var taken []int
+	for i := 0; i < 10; i++ {
+		item, err := target.Poll(time.Second)
+		if err != nil {
+			t.Fatalf("test: take drained item %d want %v got %v", i, nil, err)
+		}
+		taken = append(taken, item)
+	}
+	if n := <-drained; n != 10 {
+		t.Errorf("test: drained items want %v got %v", 10, n)
+	}
+	for i, item := range taken {
+		if item != i {
+			t.Errorf("test: drained item at %d want %v got %v", i, i, item)
+		}
+	}
+	if item, _ := source.Poll(time.Second); item != 42 || !source.Empty() {
+		t.Errorf("test: item put while draining want %v got %v", 42, item)
+	}
+}
+
+func TestBlockingQueue_DrainToClosedQueue(t *testing.T) {
+	source := fullBlockingQueue(2)
+	target := NewBlockingQueue[int](0)
+	target.Close()
+
+	n, err := source.DrainTo(target, -1)
+	if n != 0 || err != ErrQueueClosed {
+		t.Errorf("test: drain to closed queue want (%v, %v) got (%v, %v)", 0, ErrQueueClosed, n, err)
+	}
+	if items := source.ToSlice(); !slices.Equal(items, []int{0, 1}) {
+		t.Errorf("test: items left in the source want %v got %v", []int{0, 1}, items)
+	}
+
+	source = fullBlockingQueue(3)
+	target = NewBlockingQueue[int](1)
+	done := make(chan struct{})
+	go func() {
+		n, err = source.DrainTo(target, -1)
+		close(done)
+	}()
+	if item, _ := target.Poll(time.Second); item != 0 {
+		t.Errorf("test: first drained item want %v got %v", 0, item)
+	}
+	for target.Size() < 1 {
+		time.Sleep(time.Millisecond)
+	}
+	target.Close()
+	<-done
+	if n != 2 || err != ErrQueueClosed {
+		t.Errorf("test: drain closed midway want (%v, %v) got (%v, %v)", 2, ErrQueueClosed, n, err)
+	}
+	if items := source.ToSlice(); !slices.Equal(items, []int{2}) {
+		t.Errorf("test: items put back in the source want %v got %v", []int{2}, items)
+	}
+}
+
+func TestBlockingQueue_DeleteShrinks(t *testing.T) {
+	queue := NewBlockingQueue[int](0)
+	for i := 0; i < 1000; i++ {
+		queue.Push(i)
+	}
+	queue.Delete(0)
+	queue.RemoveIf(func(x int) bool { return x%2 == 0 })
+	queue.DrainTo(NewSlice[int](), -1)
+	if result := len(queue.items.buf); result != minQueueCapacity {
+		t.Errorf("test: buffer after delete and drain want %v got %v", minQueueCapacity, result)
+	}
+}
+
+func TestBlockingQueue_Collection(t *testing.T) {
+	queue := NewBlockingQueue[int](0)
+	for i := 0; i < 4; i++ {
+		queue.Push(i)
+	}
+	if !queue.Contains(2) || queue.Contains(4) {
+		t.Errorf("test: contains want %v got %v", true, queue.Contains(2))
+	}
+	if err := queue.Delete(2); err != nil || queue.Contains(2) {
+		t.Errorf("test: delete want %v got %v", nil, err)
+	}
+	if err := queue.Delete(2); err != (ErrItemNotFound{2}) {
+		t.Errorf("test: delete missing item want %v got %v", ErrItemNotFound{2}, err)
+	}
+	if queue.String() != "[0, 1, 3]" {
+		t.Errorf("test: string want %v got %v", "[0, 1, 3]", queue.String())
+	}
+	var items []int
+	for it := queue.Iterator(); it.HasNext(); {
+		items = append(items, it.Next())
+		queue.Push(9)
+	}
+	if len(items) != 3 {
+		t.Errorf("test: snapshot iterator want %v items got %v", 3, len(items))
+	}
+}
+
+func TestBlockingQueue_ProducersConsumers(t *testing.T) {
+	queue := NewBlockingQueue[int](4)
+	var producers, consumers sync.WaitGroup
+	sums := make(chan int, stressGoroutines)
+
+	for g := 0; g < stressGoroutines; g++ {
+		producers.Add(1)
+		go func() {
+			defer producers.Done()
+			for i := 1; i <= stressOperations; i++ {
+				if err := queue.Put(context.Background(), i); err != nil {
+					t.Errorf("test: put want %v got %v", nil, err)
+				}
+			}
+		}()
+		consumers.Add(1)
+		go func() {
+			defer consumers.Done()
+			sum := 0
+			for {
+				item, err := queue.Take(context.Background())
+				if err == ErrQueueClosed {
+					break
+				}
+				sum += item
+			}
+			sums <- sum
+		}()
+	}
+	producers.Wait()
+	queue.Close()
+	consumers.Wait()
+	close(sums)
+
+	total := 0
+	for sum := range sums {
+		total += sum
+	}
+	want := stressGoroutines * stressOperations * (stressOperations + 1) / 2
+	if total != want {
+		t.Errorf("test: consumed sum want %v got %v", want, total)
+	}
+}
+
+func fullBlockingQueue(capacity int) *BlockingQueue[int] {
+	queue := NewBlockingQueue[int](capacity)
+	for i := 0; i < capacity; i++ {
+		queue.Push(i)
+	}
+	return queue
+}
+
+func closedBlockingQueue() *BlockingQueue[int] {
+	queue := NewBlockingQueue[int](2)
+	queue.Close()
+	return queue
+}
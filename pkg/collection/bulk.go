@@ -0,0 +1,521 @@
+package collection
+
+import "slices"
+
+// Bulk operations of the Collection interface. Slice, LinkedList, HashSet
+// and the other collections whose layout allows it do the work in a single
+// pass, the rest rely on the helpers below built on the single item methods.
+// The items given to a bulk operation are read before the collection is
+// changed, so a collection can be given itself or a view of itself.
+
+// memberOf returns a function reporting whether an item is in items. When
+// items is a Collection not sharing the storage of self its Contains method
+// is used, otherwise the items are copied and compared with eq.
+func memberOf[E any](self any, items Iterable[E], eq EqualFunc[E]) func(item E) bool {
+	if c, ok := items.(Collection[E]); ok && viewRoot[E](c) != viewRoot[E](self) {
+		return c.Contains
+	}
+	snapshot := iterableItems(items)
+	return func(item E) bool {
+		return slices.ContainsFunc(snapshot, func(x E) bool {
+			return eq(x, item)
+		})
+	}
+}
+
+// viewRoot returns the list a subList has been taken from, following the
+// views of views, or c itself when it is not a subList.
+func viewRoot[E any](c any) any {
+	for {
+		v, ok := c.(*subList[E])
+		if !ok {
+			return c
+		}
+		c = v.parent
+	}
+}
+
+// setMemberOf is memberOf for comparable items, a copy is kept in a map.
+func setMemberOf[E comparable](self any, items Iterable[E]) func(item E) bool {
+	if c, ok := items.(Collection[E]); ok && any(c) != self {
+		return c.Contains
+	}
+	snapshot := make(map[E]bool)
+	for it := items.Iterator(); it.HasNext(); {
+		snapshot[it.Next()] = true
+	}
+	return func(item E) bool {
+		return snapshot[item]
+	}
+}
+
+func not[E any](pred func(item E) bool) func(item E) bool {
+	return func(item E) bool {
+		return !pred(item)
+	}
+}
+
+func pushAll[E any](c Collection[E], items Iterable[E]) {
+	for _, item := range iterableItems(items) {
+		c.Push(item)
+	}
+}
+
+func containsAll[E any](c Collection[E], items Iterable[E]) bool {
+	for it := items.Iterator(); it.HasNext(); {
+		if !c.Contains(it.Next()) {
+			return false
+		}
+	}
+	return true
+}
+
+// removeIf deletes the matching items one by one with Delete.
+func removeIf[E any](c Collection[E], pred func(item E) bool) int {
+	matches := slices.DeleteFunc(toSlice[E](c), not(pred))
+	for _, item := range matches {
+		c.Delete(item)
+	}
+	return len(matches)
+}
+
+func toSlice[E any](c Collection[E]) []E {
+	items := make([]E, 0, c.Size())
+	for it := c.Iterator(); it.HasNext(); {
+		items = append(items, it.Next())
+	}
+	return items
+}
+
+func (s *Slice[E]) PushAll(items Iterable[E]) {
+	s.inner = append(s.inner, iterableItems(items)...)
+	s.mods++
+}
+
+func (s *Slice[E]) ContainsAll(items Iterable[E]) bool {
+	return containsAll[E](s, items)
+}
+
+func (s *Slice[E]) DeleteAll(items Iterable[E]) int {
+	return s.RemoveIf(memberOf(s, items, s.eq()))
+}
+
+func (s *Slice[E]) RetainAll(items Iterable[E]) int {
+	return s.RemoveIf(not(memberOf(s, items, s.eq())))
+}
+
+// RemoveIf compacts the slice in a single pass.
+func (s *Slice[E]) RemoveIf(pred func(item E) bool) int {
+	n := len(s.inner)
+	s.inner = slices.DeleteFunc(s.inner, pred)
+	if removed := n - len(s.inner); removed > 0 {
+		s.mods++
+		return removed
+	}
+	return 0
+}
+
+func (s *Slice[E]) Clear() {
+	s.inner = nil
+	s.mods++
+}
+
+func (s *Slice[E]) ToSlice() []E {
+	return append(make([]E, 0, len(s.inner)), s.inner...)
+}
+
+func (l *LinkedList[E]) PushAll(items Iterable[E]) {
+	l.InsertAll(l.size, items)
+}
+
+func (l *LinkedList[E]) ContainsAll(items Iterable[E]) bool {
+	return containsAll[E](l, items)
+}
+
+func (l *LinkedList[E]) DeleteAll(items Iterable[E]) int {
+	return l.RemoveIf(memberOf(l, items, l.eq()))
+}
+
+func (l *LinkedList[E]) RetainAll(items Iterable[E]) int {
+	return l.RemoveIf(not(memberOf(l, items, l.eq())))
+}
+
+// RemoveIf unlinks the matching nodes in a single pass.
+func (l *LinkedList[E]) RemoveIf(pred func(item E) bool) int {
+	removed := 0
+	for n := l.head; n != nil; {
+		next := n.next
+		if pred(n.value) {
+			l.unlink(n)
+			removed++
+		}
+		n = next
+	}
+	return removed
+}
+
+func (l *LinkedList[E]) Clear() {
+	l.head, l.tail, l.size = nil, nil, 0
+	l.mods++
+}
+
+func (l *LinkedList[E]) ToSlice() []E {
+	items := make([]E, 0, l.size)
+	for n := l.head; n != nil; n = n.next {
+		items = append(items, n.value)
+	}
+	return items
+}
+
+func (h *HashSet[E]) PushAll(items Iterable[E]) {
+	for _, item := range iterableItems(items) {
+		h.inner[item] = true
+	}
+	h.mods++
+}
+
+func (h *HashSet[E]) ContainsAll(items Iterable[E]) bool {
+	return containsAll[E](h, items)
+}
+
+// DeleteAll deletes the items of items one by one, it takes O(m) for m items.
+func (h *HashSet[E]) DeleteAll(items Iterable[E]) int {
+	removed := 0
+	for _, item := range iterableItems(items) {
+		if h.inner[item] {
+			delete(h.inner, item)
+			removed++
+		}
+	}
+	if removed > 0 {
+		h.mods++
+	}
+	return removed
+}
+
+func (h *HashSet[E]) RetainAll(items Iterable[E]) int {
+	return h.RemoveIf(not(setMemberOf(h, items)))
+}
+
+func (h *HashSet[E]) RemoveIf(pred func(item E) bool) int {
+	removed := 0
+	for item := range h.inner {
+		if pred(item) {
+			delete(h.inner, item)
+			removed++
+		}
+	}
+	if removed > 0 {
+		h.mods++
+	}
+	return removed
+}
+
+func (h *HashSet[E]) Clear() {
+	clear(h.inner)
+	h.mods++
+}
+
+func (h *HashSet[E]) ToSlice() []E {
+	return toSlice[E](h)
+}
+
+func (h *LinkedHashSet[E]) PushAll(items Iterable[E]) {
+	pushAll[E](h, items)
+}
+
+func (h *LinkedHashSet[E]) ContainsAll(items Iterable[E]) bool {
+	return containsAll[E](h, items)
+}
+
+func (h *LinkedHashSet[E]) DeleteAll(items Iterable[E]) int {
+	return h.RemoveIf(setMemberOf(h, items))
+}
+
+func (h *LinkedHashSet[E]) RetainAll(items Iterable[E]) int {
+	return h.RemoveIf(not(setMemberOf(h, items)))
+}
+
+func (h *LinkedHashSet[E]) RemoveIf(pred func(item E) bool) int {
+	removed := 0
+	for n := h.order.head; n != nil; {
+		next := n.next
+		if pred(n.value) {
+			delete(h.table, n.value)
+			h.order.unlink(n)
+			removed++
+		}
+		n = next
+	}
+	return removed
+}
+
+func (h *LinkedHashSet[E]) Clear() {
+	clear(h.table)
+	h.order.Clear()
+}
+
+func (h *LinkedHashSet[E]) ToSlice() []E {
+	return h.order.ToSlice()
+}
+
+func (s *TreeSet[E]) PushAll(items Iterable[E]) {
+	pushAll[E](s, items)
+}
+
+func (s *TreeSet[E]) ContainsAll(items Iterable[E]) bool {
+	return containsAll[E](s, items)
+}
+
+func (s *TreeSet[E]) DeleteAll(items Iterable[E]) int {
+	return s.RemoveIf(setMemberOf(s, items))
+}
+
+func (s *TreeSet[E]) RetainAll(items Iterable[E]) int {
+	return s.RemoveIf(not(setMemberOf(s, items)))
+}
+
+// RemoveIf deletes the matching items of the set, on views only the items in range.
+func (s *TreeSet[E]) RemoveIf(pred func(item E) bool) int {
+	return removeIf[E](s, pred)
+}
+
+// Clear deletes every item of the set, on views only the items in range
+// one by one.
+func (s *TreeSet[E]) Clear() {
+	if s.lo != nil || s.hi != nil {
+		removeIf[E](s, func(E) bool { return true })
+		return
+	}
+	s.tree.root = nil
+	s.tree.size = 0
+	s.tree.mods++
+}
+
+func (s *TreeSet[E]) ToSlice() []E {
+	return toSlice[E](s)
+}
+
+func (d *Deque[E]) PushAll(items Iterable[E]) {
+	for _, item := range iterableItems(items) {
+		d.PushBack(item)
+	}
+}
+
+func (d *Deque[E]) ContainsAll(items Iterable[E]) bool {
+	return containsAll[E](d, items)
+}
+
+func (d *Deque[E]) DeleteAll(items Iterable[E]) int {
+	return d.RemoveIf(memberOf(d, items, d.eq()))
+}
+
+func (d *Deque[E]) RetainAll(items Iterable[E]) int {
+	return d.RemoveIf(not(memberOf(d, items, d.eq())))
+}
+
+// RemoveIf compacts the deque in a single pass.
+func (d *Deque[E]) RemoveIf(pred func(item E) bool) int {
+	kept := 0
+	for i := 0; i < d.size; i++ {
+		item := *d.slot(i)
+		if !pred(item) {
+			*d.slot(kept) = item
+			kept++
+		}
+	}
+	removed := d.size - kept
+	for i := kept; i < d.size; i++ {
+		*d.slot(i) = *new(E)
+	}
+	d.size = kept
+	if removed > 0 {
+		d.mods++
+		d.shrink()
+	}
+	return removed
+}
+
+func (d *Deque[E]) Clear() {
+	d.blocks, d.head, d.size = nil, 0, 0
+	d.mods++
+}
+
+func (d *Deque[E]) ToSlice() []E {
+	return toSlice[E](d)
+}
+
+func (q *PriorityQueue[E]) PushAll(items Iterable[E]) {
+	pushAll[E](q, items)
+}
+
+func (q *PriorityQueue[E]) ContainsAll(items Iterable[E]) bool {
+	return containsAll[E](q, items)
+}
+
+func (q *PriorityQueue[E]) DeleteAll(items Iterable[E]) int {
+	return q.RemoveIf(memberOf(q, items, q.eq()))
+}
+
+func (q *PriorityQueue[E]) RetainAll(items Iterable[E]) int {
+	return q.RemoveIf(not(memberOf(q, items, q.eq())))
+}
+
+// RemoveIf compacts the items and rebuilds the heap in O(n).
+func (q *PriorityQueue[E]) RemoveIf(pred func(item E) bool) int {
+	n := len(q.items)
+	q.items = slices.DeleteFunc(q.items, pred)
+	removed := n - len(q.items)
+	if removed == 0 {
+		return 0
+	}
+	for i := len(q.items)/2 - 1; i >= 0; i-- {
+		heapDown(i, len(q.items), q.less, q.swap)
+	}
+	q.mods++
+	return removed
+}
+
+func (q *PriorityQueue[E]) Clear() {
+	q.items = nil
+	q.mods++
+}
+
+// ToSlice returns the items in heap order.
+func (q *PriorityQueue[E]) ToSlice() []E {
+	return toSlice[E](q)
+}
+
+// PushAll pushes the items one by one, it blocks while the queue is full like Push.
+func (q *BlockingQueue[E]) PushAll(items Iterable[E]) {
+	pushAll[E](q, items)
+}
+
+func (q *BlockingQueue[E]) ContainsAll(items Iterable[E]) bool {
+	return containsAll[E](q, items)
+}
+
+func (q *BlockingQueue[E]) DeleteAll(items Iterable[E]) int {
+	return q.RemoveIf(memberOf(q, items, q.eq()))
+}
+
+func (q *BlockingQueue[E]) RetainAll(items Iterable[E]) int {
+	return q.RemoveIf(not(memberOf(q, items, q.eq())))
+}
+
+// RemoveIf deletes the matching items and wakes up the producers, pred is
+// called with the lock held so it must not use the queue.
+func (q *BlockingQueue[E]) RemoveIf(pred func(item E) bool) int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	items := NewQueue[E]()
+	for x := range q.items.All() {
+		if !pred(x) {
+			items.Enqueue(x)
+		}
+	}
+	removed := q.items.Size() - items.Size()
+	if removed > 0 {
+		q.items = items
+		q.notFull = q.signal(q.notFull)
+	}
+	return removed
+}
+
+func (q *BlockingQueue[E]) Clear() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.items.Clear()
+	q.notFull = q.signal(q.notFull)
+}
+
+// ToSlice returns a snapshot of the items in FIFO order.
+func (q *BlockingQueue[E]) ToSlice() []E {
+	return q.snapshot()
+}
+
+func (v *subList[E]) PushAll(items Iterable[E]) {
+	v.InsertAll(v.Size(), items)
+}
+
+func (v *subList[E]) ContainsAll(items Iterable[E]) bool {
+	return containsAll[E](v, items)
+}
+
+func (v *subList[E]) DeleteAll(items Iterable[E]) int {
+	return v.RemoveIf(memberOf(v, items, v.eq()))
+}
+
+func (v *subList[E]) RetainAll(items Iterable[E]) int {
+	return v.RemoveIf(not(memberOf(v, items, v.eq())))
+}
+
+// RemoveIf deletes the matching items through a ListIterator of the parent.
+func (v *subList[E]) RemoveIf(pred func(item E) bool) int {
+	removed := 0
+	it, _ := v.ListIterator(0)
+	for it.HasNext() {
+		if pred(it.Next()) {
+			it.Remove()
+			removed++
+		}
+	}
+	return removed
+}
+
+func (v *subList[E]) Clear() {
+	v.RemoveRange(0, v.Size())
+}
+
+func (v *subList[E]) ToSlice() []E {
+	return toSlice[E](v)
+}
+
+// The items are copied before taking the lock, items may be the collection itself.
+
+func (s *synchronizedCollection[E]) PushAll(items Iterable[E]) {
+	snapshot := NewSlice(iterableItems(items)...)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.c.PushAll(snapshot)
+}
+
+func (s *synchronizedCollection[E]) ContainsAll(items Iterable[E]) bool {
+	snapshot := NewSlice(iterableItems(items)...)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.c.ContainsAll(snapshot)
+}
+
+func (s *synchronizedCollection[E]) DeleteAll(items Iterable[E]) int {
+	snapshot := NewSlice(iterableItems(items)...)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.DeleteAll(snapshot)
+}
+
+func (s *synchronizedCollection[E]) RetainAll(items Iterable[E]) int {
+	snapshot := NewSlice(iterableItems(items)...)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.RetainAll(snapshot)
+}
+
+// RemoveIf calls pred with the lock held, it must not use the collection.
+func (s *synchronizedCollection[E]) RemoveIf(pred func(item E) bool) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.RemoveIf(pred)
+}
+
+func (s *synchronizedCollection[E]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.c.Clear()
+}
+
+func (s *synchronizedCollection[E]) ToSlice() []E {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.c.ToSlice()
+}
@@ -0,0 +1,230 @@
+package collection
+
+import (
+	"slices"
+	"testing"
+)
+
+// bulkCollections builds every collection kind from distinct items.
+var bulkCollections = []struct {
+	name string
+	new  func(items ...int) Collection[int]
+}{
+	{name: "slice", new: func(items ...int) Collection[int] { return NewSlice(items...) }},
+	{name: "linked list", new: func(items ...int) Collection[int] { return NewLinkedList(items...) }},
+	{name: "hash set", new: func(items ...int) Collection[int] { return NewHashSet(items...) }},
+	{name: "linked hash set", new: func(items ...int) Collection[int] { return NewLinkedHashSet(items...) }},
+	{name: "tree set", new: func(items ...int) Collection[int] { return NewTreeSet(items...) }},
+	{name: "deque", new: func(items ...int) Collection[int] { return NewDeque(items...) }},
+	{name: "priority queue", new: func(items ...int) Collection[int] { return NewPriorityQueue(items...) }},
+	{name: "blocking queue", new: func(items ...int) Collection[int] {
+		q := NewBlockingQueue[int](0)
+		for _, item := range items {
+			q.Push(item)
+		}
+		return q
+	}},
+	{name: "sub list", new: func(items ...int) Collection[int] {
+		view := NewLinkedList(append(append([]int{-1}, items...), -2)...).SubList(1, len(items)+1)
+		return view
+	}},
+	{name: "synchronized list", new: func(items ...int) Collection[int] { return SynchronizedList[int](NewSlice(items...)) }},
+	{name: "synchronized set", new: func(items ...int) Collection[int] { return SynchronizedSet[int](NewHashSet(items...)) }},
+}
+
+func sortedContent(c Collection[int]) []int {
+	items := c.ToSlice()
+	slices.Sort(items)
+	return items
+}
+
+func TestCollection_PushAll(t *testing.T) {
+	for _, f := range bulkCollections {
+		c := f.new(1, 2)
+		c.PushAll(NewSlice(3, 4))
+		if result := sortedContent(c); !slices.Equal(result, []int{1, 2, 3, 4}) || c.Size() != 4 {
+			t.Errorf("test: %s push all want %v got %v", f.name, []int{1, 2, 3, 4}, result)
+		}
+
+		c = f.new(1, 2)
+		c.PushAll(c)
+		if c.Size() != 4 && c.Size() != 2 {
+			t.Errorf("test: %s push itself want the items twice or once got %v", f.name, sortedContent(c))
+		}
+	}
+}
+
+func TestCollection_ContainsAll(t *testing.T) {
+	useCases := []struct {
+		description string
+		items       Iterable[int]
+		want        bool
+	}{
+		{description: "no items", items: NewSlice[int](), want: true},
+		{description: "every item", items: NewHashSet(1, 3), want: true},
+		{description: "a missing item", items: NewLinkedList(1, 4)},
+	}
+
+	for _, f := range bulkCollections {
+		for _, tt := range useCases {
+			if result := f.new(1, 2, 3).ContainsAll(tt.items); result != tt.want {
+				t.Errorf("test: %s %s want %v got %v", f.name, tt.description, tt.want, result)
+			}
+		}
+	}
+}
+
+func TestCollection_DeleteRetain(t *testing.T) {
+	useCases := []struct {
+		description string
+		change      func(c Collection[int]) int
+		removed     int
+		want        []int
+	}{
+		{description: "delete all", change: func(c Collection[int]) int { return c.DeleteAll(NewSlice(2, 4, 6)) }, removed: 2, want: []int{1, 3, 5}},
+		{description: "delete all of a set", change: func(c Collection[int]) int { return c.DeleteAll(NewHashSet(1, 9)) }, removed: 1, want: []int{2, 3, 4, 5}},
+		{description: "delete all of itself", change: func(c Collection[int]) int { return c.DeleteAll(c) }, removed: 5, want: []int{}},
+		{description: "retain all", change: func(c Collection[int]) int { return c.RetainAll(NewLinkedList(2, 4, 6)) }, removed: 3, want: []int{2, 4}},
+		{description: "retain all of itself", change: func(c Collection[int]) int { return c.RetainAll(c) }, removed: 0, want: []int{1, 2, 3, 4, 5}},
+		{description: "remove if", change: func(c Collection[int]) int { return c.RemoveIf(func(x int) bool { return x > 3 }) }, removed: 2, want: []int{1, 2, 3}},
+		{description: "remove if nothing matches", change: func(c Collection[int]) int { return c.RemoveIf(func(x int) bool { return x > 9 }) }, removed: 0, want: []int{1, 2, 3, 4, 5}},
+		{description: "clear", change: func(c Collection[int]) int { c.Clear(); return 5 }, removed: 5, want: []int{}},
+	}
+
+	for _, f := range bulkCollections {
+		for _, tt := range useCases {
+			c := f.new(1, 2, 3, 4, 5)
+			removed := tt.change(c)
+			if result := sortedContent(c); !slices.Equal(result, tt.want) || removed != tt.removed || c.Size() != len(tt.want) {
+				t.Errorf("test: %s %s want (%v, %v) got (%v, %v)", f.name, tt.description, tt.want, tt.removed, result, removed)
+			}
+		}
+	}
+}
+
+func TestCollection_RemoveIfDuplicates(t *testing.T) {
+	lists := []struct {
+		name string
+		c    Collection[int]
+	}{
+		{name: "slice", c: NewSlice(1, 2, 1, 3, 1)},
+		{name: "linked list", c: NewLinkedList(1, 2, 1, 3, 1)},
+		{name: "deque", c: NewDeque(1, 2, 1, 3, 1)},
+		{name: "priority queue", c: NewPriorityQueue(1, 2, 1, 3, 1)},
+	}
+
+	for _, tt := range lists {
+		removed := tt.c.DeleteAll(NewSlice(1))
+		if result := sortedContent(tt.c); !slices.Equal(result, []int{2, 3}) || removed != 3 {
+			t.Errorf("test: %s delete every occurrence want ([2 3], 3) got (%v, %v)", tt.name, result, removed)
+		}
+	}
+
+	q := NewPriorityQueue(5, 1, 4, 2, 3)
+	q.RemoveIf(func(x int) bool { return x%2 == 0 })
+	var popped []int
+	for !q.Empty() {
+		x, _ := q.Pop()
+		popped = append(popped, x)
+	}
+	if !slices.Equal(popped, []int{1, 3, 5}) {
+		t.Errorf("test: priority queue heap after remove if want %v got %v", []int{1, 3, 5}, popped)
+	}
+}
+
+func TestCollection_DeleteRetainView(t *testing.T) {
+	lists := []struct {
+		name string
+		new  func(items ...string) viewParent[string]
+	}{
+		{name: "slice", new: func(items ...string) viewParent[string] { return NewSlice(items...) }},
+		{name: "linked list", new: func(items ...string) viewParent[string] { return NewLinkedList(items...) }},
+		{name: "sub list", new: func(items ...string) viewParent[string] {
+			return NewSlice(append([]string{"z"}, items...)...).SubList(1, len(items)+1).(viewParent[string])
+		}},
+	}
+	useCases := []struct {
+		description string
+		change      func(l viewParent[string]) int
+		removed     int
+		want        []string
+	}{
+		{description: "delete all of a view", change: func(l viewParent[string]) int { return l.DeleteAll(l.SubList(0, 1)) }, removed: 2, want: []string{"a"}},
+		{description: "retain all of a view", change: func(l viewParent[string]) int { return l.RetainAll(l.SubList(1, 2)) }, removed: 2, want: []string{"a"}},
+		{description: "delete all of a view of a view", change: func(l viewParent[string]) int { return l.DeleteAll(l.SubList(0, 2).(viewParent[string]).SubList(1, 2)) }, removed: 1, want: []string{"b", "b"}},
+	}
+
+	for _, l := range lists {
+		for _, tt := range useCases {
+			list := l.new("b", "a", "b")
+			removed := tt.change(list)
+			if result := list.ToSlice(); !slices.Equal(result, tt.want) || removed != tt.removed {
+				t.Errorf("test: %s %s want (%v, %v) got (%v, %v)", l.name, tt.description, tt.want, tt.removed, result, removed)
+			}
+		}
+	}
+}
+
+func TestCollection_ToSlice(t *testing.T) {
+	s := NewSlice(3, 1, 2)
+	items := s.ToSlice()
+	items[0] = 9
+	if front, _ := s.Front(); front != 3 {
+		t.Errorf("test: slice to slice want a copy got %v", s)
+	}
+	if result := NewLinkedList(3, 1, 2).ToSlice(); !slices.Equal(result, []int{3, 1, 2}) {
+		t.Errorf("test: linked list to slice want %v got %v", []int{3, 1, 2}, result)
+	}
+	if result := NewLinkedHashSet(3, 1, 2).ToSlice(); !slices.Equal(result, []int{3, 1, 2}) {
+		t.Errorf("test: linked hash set to slice want %v got %v", []int{3, 1, 2}, result)
+	}
+	if result := NewSlice[int]().ToSlice(); result == nil || len(result) != 0 {
+		t.Errorf("test: empty slice to slice want an empty slice got %v", result)
+	}
+}
+
+func TestCollection_BulkConcurrentModification(t *testing.T) {
+	for _, name := range []string{"slice", "linked list", "hash set", "tree set"} {
+		var c Collection[int]
+		switch name {
+		case "slice":
+			c = NewSlice(1, 2, 3)
+		case "linked list":
+			c = NewLinkedList(1, 2, 3)
+		case "hash set":
+			c = NewHashSet(1, 2, 3)
+		case "tree set":
+			c = NewTreeSet(1, 2, 3)
+		}
+		err := modificationPanic(func() {
+			it := c.Iterator()
+			it.Next()
+			c.Clear()
+			it.Next()
+		})
+		if _, ok := err.(ErrConcurrentModification); !ok {
+			t.Errorf("test: %s clear while iterating want %T got %v", name, ErrConcurrentModification{}, err)
+		}
+	}
+}
+
+func TestTreeSet_ClearView(t *testing.T) {
+	set := NewTreeSet(1, 2, 3, 4, 5)
+	set.HeadSet(3, false).Clear()
+	if result := set.ToSlice(); !slices.Equal(result, []int{3, 4, 5}) {
+		t.Errorf("test: clear head set want %v got %v", []int{3, 4, 5}, result)
+	}
+	view := set.TailSet(4, true)
+	set.Clear()
+	set.Push(6)
+	if result := view.ToSlice(); !slices.Equal(result, []int{6}) || set.Size() != 1 {
+		t.Errorf("test: view after clear want %v got %v", []int{6}, result)
+	}
+}
+
+func BenchmarkSlice_RemoveIf(b *testing.B) {
+	items := rangeItems(0, 10000)
+	for b.Loop() {
+		NewSlice(slices.Clone(items)...).RemoveIf(func(x int) bool { return x%2 == 0 })
+	}
+}
@@ -0,0 +1,298 @@
+package collection
+
+import (
+	"fmt"
+	"time"
+)
+
+// Cache is a key value store of bounded size that evicts entries on its own,
+// see LRUCache and LFUCache.
+type Cache[K comparable, V any] interface {
+	// Get returns the value of key and counts the access as a hit or a miss.
+	Get(key K) (V, bool)
+	// Peek returns the value of key without counting nor recording the access.
+	Peek(key K) (V, bool)
+	// Put associates value to key with the default time to live.
+	Put(key K, value V)
+	// PutWithTTL associates value to key for ttl, a ttl of 0 means forever.
+	PutWithTTL(key K, value V, ttl time.Duration)
+	// Remove deletes key and reports whether it was in the cache.
+	Remove(key K) bool
+	// RemoveExpired evicts every expired entry and returns how many there were.
+	RemoveExpired() int
+	// Len returns the number of entries, expired ones included until they are removed.
+	Len() int
+	// Cost returns the total cost of the entries.
+	Cost() int64
+	// Stats returns the counters of the cache.
+	Stats() CacheStats
+}
+
+// EvictionReason tells why an entry left a cache.
+type EvictionReason int
+
+const (
+	// EvictedCapacity means the entry was evicted to honor the max entry count or the max cost.
+	EvictedCapacity EvictionReason = iota
+	// EvictedExpired means the time to live of the entry was over.
+	EvictedExpired
+)
+
+func (r EvictionReason) String() string {
+	switch r {
+	case EvictedCapacity:
+		return "capacity"
+	case EvictedExpired:
+		return "expired"
+	}
+	return fmt.Sprintf("EvictionReason(%d)", int(r))
+}
+
+// CacheStats holds the counters of a cache since its creation.
+type CacheStats struct {
+	Hits        uint64
+	Misses      uint64
+	Evictions   uint64
+	Expirations uint64
+}
+
+// HitRatio returns the share of Get calls that found their key, or 0 before the first call.
+func (s CacheStats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// CacheOption configures a cache created by NewLRUCache or NewLFUCache.
+type CacheOption[K comparable, V any] func(*cacheOptions[K, V])
+
+type cacheOptions[K comparable, V any] struct {
+	maxCost int64
+	cost    func(key K, value V) int64
+	ttl     time.Duration
+	onEvict func(key K, value V, reason EvictionReason)
+}
+
+// WithMaxCost bounds the total cost of the entries of the cache, each entry
+// costs 1 unless WithCost is given.
+func WithMaxCost[K comparable, V any](maxCost int64) CacheOption[K, V] {
+	return func(o *cacheOptions[K, V]) {
+		o.maxCost = maxCost
+	}
+}
+
+// WithCost sets the function computing the cost of an entry when it is put.
+func WithCost[K comparable, V any](fn func(key K, value V) int64) CacheOption[K, V] {
+	return func(o *cacheOptions[K, V]) {
+		o.cost = fn
+	}
+}
+
+// WithTTL sets the time to live used by Put, by default entries never expire.
+func WithTTL[K comparable, V any](ttl time.Duration) CacheOption[K, V] {
+	return func(o *cacheOptions[K, V]) {
+		o.ttl = ttl
+	}
+}
+
+// WithEvictionCallback sets a function called with every entry the cache
+// evicts or finds expired, but not with the ones deleted by Remove or
+// replaced by Put. It runs synchronously and must not use the cache.
+func WithEvictionCallback[K comparable, V any](fn func(key K, value V, reason EvictionReason)) CacheOption[K, V] {
+	return func(o *cacheOptions[K, V]) {
+		o.onEvict = fn
+	}
+}
+
+// cachePolicy decides which key a cache evicts when it is full.
+type cachePolicy[K comparable] interface {
+	add(key K)
+	touch(key K)
+	remove(key K)
+	// victim returns the key to evict next other than keep.
+	victim(keep K) (K, bool)
+}
+
+// firstExcept returns the first key of keys other than keep.
+func firstExcept[K comparable](keys *LinkedHashSet[K], keep K) (K, bool) {
+	for it := keys.Iterator(); it.HasNext(); {
+		if key := it.Next(); key != keep {
+			return key, true
+		}
+	}
+	return *new(K), false
+}
+
+type cacheEntry[V any] struct {
+	value   V
+	cost    int64
+	expires time.Time
+}
+
+// cache holds what LRUCache and LFUCache have in common, they only differ
+// by their policy.
+type cache[K comparable, V any] struct {
+	entries    map[K]*cacheEntry[V]
+	policy     cachePolicy[K]
+	maxEntries int
+	cost       int64
+	opts       cacheOptions[K, V]
+	stats      CacheStats
+	now        func() time.Time
+}
+
+func newCache[K comparable, V any](maxEntries int, policy cachePolicy[K], opts []CacheOption[K, V]) *cache[K, V] {
+	c := &cache[K, V]{
+		entries:    make(map[K]*cacheEntry[V]),
+		policy:     policy,
+		maxEntries: maxEntries,
+		now:        time.Now,
+	}
+	for _, opt := range opts {
+		opt(&c.opts)
+	}
+	return c
+}
+
+func (c *cache[K, V]) expired(e *cacheEntry[V]) bool {
+	return !e.expires.IsZero() && !c.now().Before(e.expires)
+}
+
+// lookup returns the live entry of key, an expired one is evicted.
+func (c *cache[K, V]) lookup(key K) (*cacheEntry[V], bool) {
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if c.expired(e) {
+		c.evict(key, EvictedExpired)
+		return nil, false
+	}
+	return e, true
+}
+
+func (c *cache[K, V]) Get(key K) (V, bool) {
+	e, ok := c.lookup(key)
+	if !ok {
+		c.stats.Misses++
+		return *new(V), false
+	}
+	c.stats.Hits++
+	c.policy.touch(key)
+	return e.value, true
+}
+
+func (c *cache[K, V]) Peek(key K) (V, bool) {
+	e, ok := c.entries[key]
+	if !ok || c.expired(e) {
+		return *new(V), false
+	}
+	return e.value, true
+}
+
+func (c *cache[K, V]) Put(key K, value V) {
+	c.PutWithTTL(key, value, c.opts.ttl)
+}
+
+// PutWithTTL associates value to key for ttl and evicts other entries until
+// the cache is within its bounds again. An entry costing more than the max
+// cost is evicted right away.
+func (c *cache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	e := &cacheEntry[V]{value: value, cost: 1}
+	if c.opts.cost != nil {
+		e.cost = c.opts.cost(key, value)
+	}
+	if ttl > 0 {
+		e.expires = c.now().Add(ttl)
+	}
+
+	old, exists := c.entries[key]
+	if exists {
+		c.cost -= old.cost
+	}
+	c.entries[key] = e
+	c.cost += e.cost
+
+	if c.opts.maxCost > 0 && e.cost > c.opts.maxCost {
+		c.evict(key, EvictedCapacity)
+		return
+	}
+	if exists {
+		c.policy.touch(key)
+	}
+	for c.full() {
+		victim, ok := c.policy.victim(key)
+		if !ok {
+			break
+		}
+		c.evict(victim, EvictedCapacity)
+	}
+	if !exists {
+		c.policy.add(key)
+	}
+}
+
+func (c *cache[K, V]) full() bool {
+	return (c.maxEntries > 0 && len(c.entries) > c.maxEntries) ||
+		(c.opts.maxCost > 0 && c.cost > c.opts.maxCost)
+}
+
+// delete removes key and returns its entry.
+func (c *cache[K, V]) delete(key K) (*cacheEntry[V], bool) {
+	e, ok := c.entries[key]
+	if ok {
+		delete(c.entries, key)
+		c.policy.remove(key)
+		c.cost -= e.cost
+	}
+	return e, ok
+}
+
+func (c *cache[K, V]) evict(key K, reason EvictionReason) {
+	e, ok := c.delete(key)
+	if !ok {
+		return
+	}
+	if reason == EvictedExpired {
+		c.stats.Expirations++
+	} else {
+		c.stats.Evictions++
+	}
+	if c.opts.onEvict != nil {
+		c.opts.onEvict(key, e.value, reason)
+	}
+}
+
+func (c *cache[K, V]) Remove(key K) bool {
+	_, ok := c.delete(key)
+	return ok
+}
+
+// RemoveExpired evicts every expired entry and returns how many there were, it takes O(n).
+func (c *cache[K, V]) RemoveExpired() int {
+	var keys []K
+	for k, e := range c.entries {
+		if c.expired(e) {
+			keys = append(keys, k)
+		}
+	}
+	for _, k := range keys {
+		c.evict(k, EvictedExpired)
+	}
+	return len(keys)
+}
+
+func (c *cache[K, V]) Len() int {
+	return len(c.entries)
+}
+
+// Cost returns the total cost of the entries of the cache.
+func (c *cache[K, V]) Cost() int64 {
+	return c.cost
+}
+
+func (c *cache[K, V]) Stats() CacheStats {
+	return c.stats
+}
@@ -0,0 +1,194 @@
+package collection
+
+import (
+	"testing"
+	"time"
+)
+
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) now() time.Time {
+	return c.t
+}
+
+// cacheFactories builds every cache kind so that the shared behavior is tested on both.
+var cacheFactories = []struct {
+	name string
+	new  func(maxEntries int, opts ...CacheOption[string, int]) *cache[string, int]
+}{
+	{name: "lru", new: func(maxEntries int, opts ...CacheOption[string, int]) *cache[string, int] {
+		return NewLRUCache(maxEntries, opts...).cache
+	}},
+	{name: "lfu", new: func(maxEntries int, opts ...CacheOption[string, int]) *cache[string, int] {
+		return NewLFUCache(maxEntries, opts...).cache
+	}},
+}
+
+func TestCache_GetPeek(t *testing.T) {
+	for _, f := range cacheFactories {
+		c := f.new(2)
+		c.Put("a", 1)
+		c.Put("b", 2)
+		c.Put("a", 3)
+
+		useCases := []struct {
+			description string
+			key         string
+			want        int
+			ok          bool
+		}{
+			{description: "replaced key", key: "a", want: 3, ok: true},
+			{description: "present key", key: "b", want: 2, ok: true},
+			{description: "missing key", key: "c"},
+		}
+
+		for _, tt := range useCases {
+			if result, ok := c.Peek(tt.key); result != tt.want || ok != tt.ok {
+				t.Errorf("test: %s %s peek want (%v, %v) got (%v, %v)", f.name, tt.description, tt.want, tt.ok, result, ok)
+			}
+			if result, ok := c.Get(tt.key); result != tt.want || ok != tt.ok {
+				t.Errorf("test: %s %s get want (%v, %v) got (%v, %v)", f.name, tt.description, tt.want, tt.ok, result, ok)
+			}
+		}
+
+		want := CacheStats{Hits: 2, Misses: 1}
+		if stats := c.Stats(); stats != want || c.Len() != 2 {
+			t.Errorf("test: %s stats want %+v got %+v len %d", f.name, want, stats, c.Len())
+		}
+		if ratio := c.Stats().HitRatio(); ratio != 2.0/3 {
+			t.Errorf("test: %s hit ratio want %v got %v", f.name, 2.0/3, ratio)
+		}
+	}
+}
+
+func TestCache_Remove(t *testing.T) {
+	for _, f := range cacheFactories {
+		evicted := 0
+		c := f.new(2, WithEvictionCallback(func(string, int, EvictionReason) { evicted++ }))
+		c.Put("a", 1)
+		c.Put("b", 2)
+
+		if !c.Remove("a") || c.Remove("a") || c.Len() != 1 {
+			t.Errorf("test: %s remove want (true, false) len 1 got len %d", f.name, c.Len())
+		}
+		c.Put("c", 3)
+		if _, ok := c.Peek("b"); !ok || c.Len() != 2 || evicted != 0 {
+			t.Errorf("test: %s put after remove want no eviction got %d len %d", f.name, evicted, c.Len())
+		}
+	}
+}
+
+func TestCache_TTL(t *testing.T) {
+	for _, f := range cacheFactories {
+		clock := &fakeClock{t: time.Unix(0, 0)}
+		var reasons []EvictionReason
+		c := f.new(0, WithTTL[string, int](time.Minute), WithEvictionCallback(func(_ string, _ int, reason EvictionReason) {
+			reasons = append(reasons, reason)
+		}))
+		c.now = clock.now
+
+		c.Put("a", 1)
+		c.PutWithTTL("b", 2, time.Hour)
+		c.PutWithTTL("c", 3, 0)
+
+		clock.t = clock.t.Add(time.Minute)
+		if _, ok := c.Peek("a"); ok {
+			t.Errorf("test: %s peek expired key want false", f.name)
+		}
+		if c.Len() != 3 {
+			t.Errorf("test: %s peek want expired key kept got len %d", f.name, c.Len())
+		}
+		if _, ok := c.Get("a"); ok {
+			t.Errorf("test: %s get expired key want false", f.name)
+		}
+		if _, ok := c.Get("b"); !ok {
+			t.Errorf("test: %s get live key want true", f.name)
+		}
+
+		clock.t = clock.t.Add(24 * time.Hour)
+		if n := c.RemoveExpired(); n != 1 || c.Len() != 1 {
+			t.Errorf("test: %s remove expired want 1 removed and len 1 got %d len %d", f.name, n, c.Len())
+		}
+		if _, ok := c.Get("c"); !ok {
+			t.Errorf("test: %s key without ttl want kept", f.name)
+		}
+
+		want := CacheStats{Hits: 2, Misses: 1, Expirations: 2}
+		if stats := c.Stats(); stats != want || len(reasons) != 2 || reasons[0] != EvictedExpired {
+			t.Errorf("test: %s stats want %+v got %+v reasons %v", f.name, want, stats, reasons)
+		}
+	}
+}
+
+func TestCache_Cost(t *testing.T) {
+	for _, f := range cacheFactories {
+		var evicted []string
+		c := f.new(0,
+			WithMaxCost[string, int](10),
+			WithCost(func(_ string, value int) int64 { return int64(value) }),
+			WithEvictionCallback(func(key string, _ int, reason EvictionReason) {
+				if reason != EvictedCapacity {
+					t.Errorf("test: %s reason want %v got %v", f.name, EvictedCapacity, reason)
+				}
+				evicted = append(evicted, key)
+			}))
+
+		c.Put("a", 4)
+		c.Put("b", 4)
+		if c.Cost() != 8 || len(evicted) != 0 {
+			t.Errorf("test: %s cost want 8 and no eviction got %d %v", f.name, c.Cost(), evicted)
+		}
+		c.Put("c", 4)
+		if c.Cost() != 8 || len(evicted) != 1 || evicted[0] != "a" {
+			t.Errorf("test: %s over max cost want a evicted got %v cost %d", f.name, evicted, c.Cost())
+		}
+		c.Put("b", 1)
+		if c.Cost() != 5 || len(evicted) != 1 {
+			t.Errorf("test: %s replace want cost 5 got %d %v", f.name, c.Cost(), evicted)
+		}
+		c.Put("d", 11)
+		if _, ok := c.Peek("d"); ok || c.Cost() != 5 || c.Len() != 2 {
+			t.Errorf("test: %s entry above max cost want evicted alone got cost %d len %d", f.name, c.Cost(), c.Len())
+		}
+		if stats := c.Stats(); stats.Evictions != 2 {
+			t.Errorf("test: %s evictions want %d got %d", f.name, 2, stats.Evictions)
+		}
+	}
+}
+
+func TestCache_PutKeepsUpdatedKey(t *testing.T) {
+	for _, f := range cacheFactories {
+		c := f.new(0,
+			WithMaxCost[string, int](10),
+			WithCost(func(_ string, value int) int64 { return int64(value) }))
+
+		c.Put("a", 4)
+		for range 3 {
+			c.Get("a")
+		}
+		c.Put("b", 2)
+		c.Put("b", 7)
+		if v, ok := c.Peek("b"); !ok || v != 7 || c.Len() != 1 || c.Cost() != 7 {
+			t.Errorf("test: %s updated key over max cost want b kept got %v %v len %d cost %d", f.name, v, ok, c.Len(), c.Cost())
+		}
+	}
+}
+
+func TestEvictionReason_String(t *testing.T) {
+	useCases := []struct {
+		reason EvictionReason
+		want   string
+	}{
+		{reason: EvictedCapacity, want: "capacity"},
+		{reason: EvictedExpired, want: "expired"},
+		{reason: EvictionReason(7), want: "EvictionReason(7)"},
+	}
+
+	for _, tt := range useCases {
+		if result := tt.reason.String(); result != tt.want {
+			t.Errorf("test: %d want %s got %s", int(tt.reason), tt.want, result)
+		}
+	}
+}
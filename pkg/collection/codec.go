@@ -0,0 +1,318 @@
+package collection
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"iter"
+)
+
+var (
+	ErrInvalidEncoding    = fmt.Errorf("invalid collection encoding")
+	ErrUnsupportedVersion = fmt.Errorf("unsupported collection encoding version")
+	ErrChecksumMismatch   = fmt.Errorf("collection encoding checksum mismatch")
+)
+
+// The binary format written by Encode is
+//
+//	header  "GCOL" version
+//	record  0x01 uvarint(len) payload     repeated for every item
+//	trailer 0x00 uvarint(count) crc32
+//
+// where the CRC32 (IEEE) covers every byte before it, so a truncated or
+// damaged stream is detected when the trailer is read.
+
+const (
+	codecMagic   = "GCOL"
+	codecVersion = 1
+
+	codecRecordTag  = 0x01
+	codecTrailerTag = 0x00
+
+	// codecMaxRecordSize bounds the length prefix of a record.
+	codecMaxRecordSize = 1 << 30
+)
+
+// ElementCodec converts items of type E to and from bytes.
+type ElementCodec[E any] interface {
+	// Append appends the encoding of item to dst and returns the extended buffer.
+	Append(dst []byte, item E) ([]byte, error)
+	// Decode decodes an item from data, the whole payload of one record.
+	// data is not reused by the decoder so the item may keep it.
+	Decode(data []byte) (E, error)
+}
+
+type codecFuncs[E any] struct {
+	append func(dst []byte, item E) ([]byte, error)
+	decode func(data []byte) (E, error)
+}
+
+func (c codecFuncs[E]) Append(dst []byte, item E) ([]byte, error) {
+	return c.append(dst, item)
+}
+
+func (c codecFuncs[E]) Decode(data []byte) (E, error) {
+	return c.decode(data)
+}
+
+// CodecOf builds an ElementCodec from a pair of functions.
+func CodecOf[E any](encode func(dst []byte, item E) ([]byte, error), decode func(data []byte) (E, error)) ElementCodec[E] {
+	return codecFuncs[E]{append: encode, decode: decode}
+}
+
+// StringCodec encodes strings as their raw bytes.
+func StringCodec() ElementCodec[string] {
+	return CodecOf(func(dst []byte, item string) ([]byte, error) {
+		return append(dst, item...), nil
+	}, func(data []byte) (string, error) {
+		return string(data), nil
+	})
+}
+
+// IntCodec encodes signed integers as zig-zag varints.
+func IntCodec[E ~int | ~int8 | ~int16 | ~int32 | ~int64]() ElementCodec[E] {
+	return CodecOf(func(dst []byte, item E) ([]byte, error) {
+		return binary.AppendVarint(dst, int64(item)), nil
+	}, func(data []byte) (E, error) {
+		v, n := binary.Varint(data)
+		if n <= 0 || n != len(data) {
+			return 0, ErrInvalidEncoding
+		}
+		return E(v), nil
+	})
+}
+
+// JSONCodec encodes items with encoding/json, it works with any type json supports.
+func JSONCodec[E any]() ElementCodec[E] {
+	return CodecOf(func(dst []byte, item E) ([]byte, error) {
+		data, err := json.Marshal(item)
+		if err != nil {
+			return nil, err
+		}
+		return append(dst, data...), nil
+	}, func(data []byte) (E, error) {
+		var item E
+		err := json.Unmarshal(data, &item)
+		return item, err
+	})
+}
+
+// EntryCodec encodes map entries as the length prefixed key followed by the value.
+func EntryCodec[K comparable, V any](keys ElementCodec[K], values ElementCodec[V]) ElementCodec[*Entry[K, V]] {
+	return CodecOf(func(dst []byte, e *Entry[K, V]) ([]byte, error) {
+		key, err := keys.Append(nil, e.key)
+		if err != nil {
+			return nil, err
+		}
+		dst = binary.AppendUvarint(dst, uint64(len(key)))
+		dst = append(dst, key...)
+		return values.Append(dst, e.value)
+	}, func(data []byte) (*Entry[K, V], error) {
+		size, n := binary.Uvarint(data)
+		if n <= 0 || size > uint64(len(data)-n) {
+			return nil, ErrInvalidEncoding
+		}
+		data = data[n:]
+		key, err := keys.Decode(data[:size])
+		if err != nil {
+			return nil, err
+		}
+		value, err := values.Decode(data[size:])
+		if err != nil {
+			return nil, err
+		}
+		return NewEntry(key, value), nil
+	})
+}
+
+// Encode streams the items of c to w with codec one record at a time, in
+// the order of its iterator.
+func Encode[E any](w io.Writer, c Iterable[E], codec ElementCodec[E]) error {
+	return encodeSeq(w, Seq(c.Iterator()), codec)
+}
+
+// EncodeSeq is Encode for a sequence, for example the All method of a Queue
+// or of a Stack which have no iterator.
+func EncodeSeq[E any](w io.Writer, seq iter.Seq[E], codec ElementCodec[E]) error {
+	return encodeSeq(w, seq, codec)
+}
+
+// EncodeMap streams the entries of m to w in the order of its ForEach method.
+func EncodeMap[K comparable, V any](w io.Writer, m Map[K, V], keys ElementCodec[K], values ElementCodec[V]) error {
+	return EncodeMapSeq(w, func(yield func(K, V) bool) {
+		stopped := false
+		m.ForEach(func(key K, value V) {
+			if !stopped {
+				stopped = !yield(key, value)
+			}
+		})
+	}, keys, values)
+}
+
+// EncodeMapSeq is EncodeMap for a sequence of pairs, for example the All method of a map.
+func EncodeMapSeq[K comparable, V any](w io.Writer, seq iter.Seq2[K, V], keys ElementCodec[K], values ElementCodec[V]) error {
+	return encodeSeq(w, func(yield func(*Entry[K, V]) bool) {
+		for k, v := range seq {
+			if !yield(&Entry[K, V]{k, v}) {
+				return
+			}
+		}
+	}, EntryCodec(keys, values))
+}
+
+func encodeSeq[E any](w io.Writer, seq iter.Seq[E], codec ElementCodec[E]) error {
+	bw := bufio.NewWriter(w)
+	crc := crc32.NewIEEE()
+	out := io.MultiWriter(bw, crc)
+
+	if _, err := io.WriteString(out, codecMagic); err != nil {
+		return err
+	}
+	if _, err := out.Write([]byte{codecVersion}); err != nil {
+		return err
+	}
+
+	var count uint64
+	var payload, record []byte
+	for item := range seq {
+		var err error
+		payload, err = codec.Append(payload[:0], item)
+		if err != nil {
+			return err
+		}
+		record = append(record[:0], codecRecordTag)
+		record = binary.AppendUvarint(record, uint64(len(payload)))
+		record = append(record, payload...)
+		if _, err := out.Write(record); err != nil {
+			return err
+		}
+		count++
+	}
+
+	record = append(record[:0], codecTrailerTag)
+	record = binary.AppendUvarint(record, count)
+	if _, err := out.Write(record); err != nil {
+		return err
+	}
+	if _, err := bw.Write(binary.BigEndian.AppendUint32(nil, crc.Sum32())); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// Decode reads the records written by Encode from r and pushes every item
+// to c as soon as it is decoded, see DecodeFunc for the errors.
+func Decode[E any](r io.Reader, c Collection[E], codec ElementCodec[E]) error {
+	return DecodeFunc(r, codec, c.Push)
+}
+
+// DecodeFunc reads the records written by Encode from r and gives every item
+// to push as soon as it is decoded, for example the Enqueue method of a Queue.
+// When the stream is damaged this method return ErrChecksumMismatch, when it is
+// truncated io.ErrUnexpectedEOF error, in both cases push may have been
+// called with the items read so far.
+func DecodeFunc[E any](r io.Reader, codec ElementCodec[E], push func(item E)) error {
+	br := bufio.NewReader(r)
+	crc := crc32.NewIEEE()
+	in := &checksumReader{r: br, crc: crc}
+
+	header := make([]byte, len(codecMagic)+1)
+	if _, err := io.ReadFull(in, header); err != nil {
+		return unexpectedEOF(err)
+	}
+	if string(header[:len(codecMagic)]) != codecMagic {
+		return ErrInvalidEncoding
+	}
+	if header[len(codecMagic)] != codecVersion {
+		return ErrUnsupportedVersion
+	}
+
+	var count uint64
+	for {
+		tag, err := in.ReadByte()
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		size, err := binary.ReadUvarint(in)
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+
+		if tag == codecTrailerTag {
+			sum := crc.Sum32()
+			var trailer [4]byte
+			if _, err := io.ReadFull(br, trailer[:]); err != nil {
+				return unexpectedEOF(err)
+			}
+			if binary.BigEndian.Uint32(trailer[:]) != sum {
+				return ErrChecksumMismatch
+			}
+			if size != count {
+				return ErrInvalidEncoding
+			}
+			return nil
+		}
+		if tag != codecRecordTag || size > codecMaxRecordSize {
+			return ErrInvalidEncoding
+		}
+
+		// the buffer grows with the bytes actually read, so a damaged length
+		// fails on the end of the stream instead of allocating size bytes
+		// first. It is new for every record since the item may keep it.
+		var payload bytes.Buffer
+		if _, err := io.CopyN(&payload, in, int64(size)); err != nil {
+			return unexpectedEOF(err)
+		}
+		item, err := codec.Decode(payload.Bytes())
+		if err != nil {
+			return err
+		}
+		push(item)
+		count++
+	}
+}
+
+// DecodeMap reads the entries written by EncodeMap and puts them into m.
+func DecodeMap[K comparable, V any](r io.Reader, m Map[K, V], keys ElementCodec[K], values ElementCodec[V]) error {
+	return DecodeMapFunc(r, keys, values, m.Put)
+}
+
+// DecodeMapFunc reads the entries written by EncodeMap and gives them to put.
+func DecodeMapFunc[K comparable, V any](r io.Reader, keys ElementCodec[K], values ElementCodec[V], put func(key K, value V)) error {
+	return DecodeFunc(r, EntryCodec(keys, values), func(e *Entry[K, V]) {
+		put(e.key, e.value)
+	})
+}
+
+func unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// checksumReader feeds the bytes it reads to a CRC32 hash.
+type checksumReader struct {
+	r   *bufio.Reader
+	crc hash.Hash32
+}
+
+func (c *checksumReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.crc.Write(p[:n])
+	return n, err
+}
+
+func (c *checksumReader) ReadByte() (byte, error) {
+	b, err := c.r.ReadByte()
+	if err == nil {
+		c.crc.Write([]byte{b})
+	}
+	return b, err
+}
@@ -0,0 +1,201 @@
+package collection
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"reflect"
+	"runtime"
+	"testing"
+)
+
+type point struct {
+	X, Y int
+}
+
+func TestEncode(t *testing.T) {
+	useCases := []struct {
+		description string
+		encode      func(w io.Writer) error
+		decode      func(r io.Reader) (any, error)
+		want        any
+	}{
+		{
+			description: "strings",
+			encode: func(w io.Writer) error {
+				return Encode[string](w, NewLinkedList("a", "", "ccc"), StringCodec())
+			},
+			decode: func(r io.Reader) (any, error) {
+				lst := NewLinkedList[string]()
+				err := Decode[string](r, lst, StringCodec())
+				return Collect(lst.All()).inner, err
+			},
+			want: []string{"a", "", "ccc"},
+		},
+		{
+			description: "ints",
+			encode: func(w io.Writer) error {
+				return EncodeSeq(w, NewQueue(-1, 0, 1<<40).All(), IntCodec[int]())
+			},
+			decode: func(r io.Reader) (any, error) {
+				q := NewQueue[int]()
+				err := DecodeFunc(r, IntCodec[int](), q.Enqueue)
+				return Collect(q.All()).inner, err
+			},
+			want: []int{-1, 0, 1 << 40},
+		},
+		{
+			description: "empty collection",
+			encode: func(w io.Writer) error {
+				return Encode[int](w, NewSlice[int](), IntCodec[int]())
+			},
+			decode: func(r io.Reader) (any, error) {
+				s := NewSlice[int]()
+				err := Decode[int](r, s, IntCodec[int]())
+				return s.inner, err
+			},
+			want: []int(nil),
+		},
+		{
+			description: "json items",
+			encode: func(w io.Writer) error {
+				return Encode[point](w, NewSlice(point{1, 2}, point{3, 4}), JSONCodec[point]())
+			},
+			decode: func(r io.Reader) (any, error) {
+				s := NewSlice[point]()
+				err := Decode[point](r, s, JSONCodec[point]())
+				return s.inner, err
+			},
+			want: []point{{1, 2}, {3, 4}},
+		},
+		{
+			description: "map",
+			encode: func(w io.Writer) error {
+				m := NewTreeMap(NewEntry("a", 1), NewEntry("bb", -2))
+				return EncodeMap[string, int](w, m, StringCodec(), IntCodec[int]())
+			},
+			decode: func(r io.Reader) (any, error) {
+				m := NewHashMap[string, int]()
+				err := DecodeMap[string, int](r, m, StringCodec(), IntCodec[int]())
+				return m.table, err
+			},
+			want: map[string]int{"a": 1, "bb": -2},
+		},
+		{
+			description: "map sequence",
+			encode: func(w io.Writer) error {
+				m := NewLinkedHashMap(NewEntry("b", 2), NewEntry("a", 1))
+				return EncodeMapSeq(w, m.All(), StringCodec(), IntCodec[int]())
+			},
+			decode: func(r io.Reader) (any, error) {
+				var keys []string
+				err := DecodeMapFunc(r, StringCodec(), IntCodec[int](), func(key string, value int) {
+					keys = append(keys, key)
+				})
+				return keys, err
+			},
+			want: []string{"b", "a"},
+		},
+	}
+
+	for _, tt := range useCases {
+		var buf bytes.Buffer
+		if err := tt.encode(&buf); err != nil {
+			t.Errorf("test: %s encode want %v got %v", tt.description, nil, err)
+			continue
+		}
+		got, err := tt.decode(&buf)
+		if err != nil || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("test: %s want (%v, %v) got (%v, %v)", tt.description, tt.want, nil, got, err)
+		}
+	}
+}
+
+func TestDecode_KeepsPayload(t *testing.T) {
+	// the item is the payload itself
+	raw := CodecOf(func(dst []byte, item []byte) ([]byte, error) {
+		return append(dst, item...), nil
+	}, func(data []byte) ([]byte, error) {
+		return data, nil
+	})
+	var buf bytes.Buffer
+	EncodeSeq(&buf, NewSlice([]byte("first"), []byte("2nd")).All(), raw)
+
+	s := NewSlice[[]byte]()
+	if err := Decode[[]byte](&buf, s, raw); err != nil {
+		t.Errorf("test: decode raw bytes want %v got %v", nil, err)
+	}
+	first, _ := s.Front()
+	second, _ := s.Back()
+	if string(first) != "first" || string(second) != "2nd" {
+		t.Errorf("test: decoded payloads want [first 2nd] got [%s %s]", first, second)
+	}
+}
+
+func TestDecode_Errors(t *testing.T) {
+	var buf bytes.Buffer
+	Encode[string](&buf, NewSlice("hello", "world"), StringCodec())
+	valid := buf.Bytes()
+
+	flipped := bytes.Clone(valid)
+	flipped[8] ^= 0x01
+	version := bytes.Clone(valid)
+	version[4] = 9
+	// the first record claims almost 1 GiB
+	damagedLength := append(bytes.Clone(valid[:6]), binary.AppendUvarint(nil, codecMaxRecordSize-1)...)
+	damagedLength = append(damagedLength, valid[7:]...)
+	tooLong := append(bytes.Clone(valid[:6]), binary.AppendUvarint(nil, codecMaxRecordSize+1)...)
+
+	useCases := []struct {
+		description string
+		data        []byte
+		err         error
+	}{
+		{description: "empty input", data: nil, err: io.ErrUnexpectedEOF},
+		{description: "wrong magic", data: []byte("JSON{}"), err: ErrInvalidEncoding},
+		{description: "unknown version", data: version, err: ErrUnsupportedVersion},
+		{description: "truncated in a record", data: valid[:10], err: io.ErrUnexpectedEOF},
+		{description: "truncated before the trailer", data: valid[:len(valid)-6], err: io.ErrUnexpectedEOF},
+		{description: "truncated checksum", data: valid[:len(valid)-2], err: io.ErrUnexpectedEOF},
+		{description: "damaged payload", data: flipped, err: ErrChecksumMismatch},
+		{description: "damaged length", data: damagedLength, err: io.ErrUnexpectedEOF},
+		{description: "length over the limit", data: tooLong, err: ErrInvalidEncoding},
+	}
+
+	for _, tt := range useCases {
+		err := DecodeFunc(bytes.NewReader(tt.data), StringCodec(), func(string) {})
+		if !errors.Is(err, tt.err) {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.err, err)
+		}
+	}
+}
+
+func TestDecode_DamagedLengthAllocation(t *testing.T) {
+	data := append([]byte(codecMagic), codecVersion, codecRecordTag)
+	data = binary.AppendUvarint(data, codecMaxRecordSize)
+	data = append(data, "short"...)
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	err := DecodeFunc(bytes.NewReader(data), StringCodec(), func(string) {})
+	runtime.ReadMemStats(&after)
+	if err != io.ErrUnexpectedEOF {
+		t.Errorf("test: damaged length want %v got %v", io.ErrUnexpectedEOF, err)
+	}
+	if allocated := after.TotalAlloc - before.TotalAlloc; allocated > 1<<20 {
+		t.Errorf("test: allocated bytes for a damaged length want at most %v got %v", 1<<20, allocated)
+	}
+}
+
+func BenchmarkEncode(b *testing.B) {
+	q := NewQueue[int]()
+	for i := 0; i < 10000; i++ {
+		q.Enqueue(i)
+	}
+	codec := IntCodec[int]()
+	b.ReportAllocs()
+	for b.Loop() {
+		EncodeSeq(io.Discard, q.All(), codec)
+	}
+}
@@ -6,6 +6,28 @@ type Iterator[E any] interface {
 	NextWithIndex() (int, E)
 }
 
+// MutableIterator is an Iterator that can remove from its collection the
+// last item returned by Next, which is the only way to change the structure
+// of a collection while iterating over it.
+type MutableIterator[E any] interface {
+	Iterator[E]
+	Remove() error
+}
+
+// ListIterator is a MutableIterator over a List that can move in both
+// directions and change the list at its position. The cursor always sits
+// between two items: Next returns the item after it and Previous the item
+// before it. Remove and Set act on the item returned by the last call to
+// Next or Previous, Add inserts before the cursor. Previous panics with
+// ErrIllegalIteratorState when HasPrevious is false.
+type ListIterator[E any] interface {
+	MutableIterator[E]
+	HasPrevious() bool
+	Previous() E
+	Set(item E) error
+	Add(item E)
+}
+
 type Iterable[E any] interface {
 	Iterator() Iterator[E]
 }
@@ -17,6 +39,20 @@ type Collection[E any] interface {
 	Push(item E)
 	Contains(item E) bool
 	Delete(item E) error
+	// PushAll pushes every item of items.
+	PushAll(items Iterable[E])
+	// ContainsAll reports whether every item of items is in the collection.
+	ContainsAll(items Iterable[E]) bool
+	// DeleteAll deletes every item that is also in items and returns how many were deleted.
+	DeleteAll(items Iterable[E]) int
+	// RetainAll deletes every item that is not in items and returns how many were deleted.
+	RetainAll(items Iterable[E]) int
+	// RemoveIf deletes every item for which pred is true and returns how many were deleted.
+	RemoveIf(pred func(item E) bool) int
+	// Clear deletes every item.
+	Clear()
+	// ToSlice returns a new slice with the items in iteration order.
+	ToSlice() []E
 }
 
 type List[E any] interface {
@@ -30,6 +66,7 @@ type List[E any] interface {
 	GetAt(pos int) (E, error)
 	PushAt(item E, pos int)
 	DeleteAt(pos int) error
+	ListIterator(startPos int) (ListIterator[E], error)
 }
 
 type Set[E comparable] interface {
@@ -47,4 +84,26 @@ type Map[K comparable, V any] interface {
 	Keys() Set[K]
 	Values() Collection[V]
 	EntryList() Collection[*Entry[K, V]]
+	// GetOrDefault returns the value of key, or def when key is not in the map.
+	GetOrDefault(key K, def V) V
+	// PutIfAbsent stores value only when key is not in the map, it returns the value now associated to key and whether it was already there.
+	PutIfAbsent(key K, value V) (actual V, loaded bool)
+	// ComputeIfAbsent returns the value of key, when key is not in the map the value is computed by fn and stored first.
+	ComputeIfAbsent(key K, fn func(key K) V) V
+	// ComputeIfPresent replaces the value of key with the one returned by fn, or removes key when fn returns false, nothing happens when key is not in the map.
+	ComputeIfPresent(key K, fn func(key K, old V) (V, bool)) (V, bool)
+	// Compute replaces the value of key with the one returned by fn, or removes key when fn returns false.
+	Compute(key K, fn func(key K, old V, found bool) (V, bool)) (V, bool)
+	// Merge stores value when key is not in the map, otherwise it combines the current value and value with fn.
+	Merge(key K, value V, fn func(old V, value V) (V, bool)) (V, bool)
+	// Replace sets the value of key only when key is in the map, it returns the previous value and whether it was replaced.
+	Replace(key K, value V) (old V, replaced bool)
+	// ReplaceAll replaces every value with the one returned by fn.
+	ReplaceAll(fn func(key K, value V) V)
+	// ForEach calls fn with every pair of the map.
+	ForEach(fn func(key K, value V))
+	// Clear removes every pair of the map.
+	Clear()
+	// PutAll copies the pairs of other into the map.
+	PutAll(other Map[K, V])
 }
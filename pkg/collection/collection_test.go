@@ -0,0 +1,322 @@
+package collection
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// modificationPanic runs fn and returns the ErrConcurrentModification it panicked with.
+func modificationPanic(fn func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = r.(ErrConcurrentModification)
+		}
+	}()
+	fn()
+	return nil
+}
+
+func TestIterator_ConcurrentModification(t *testing.T) {
+	useCases := []struct {
+		description string
+		collection  Collection[int]
+	}{
+		{description: "slice", collection: NewSlice(1, 2, 3)},
+		{description: "linked list", collection: NewLinkedList(1, 2, 3)},
+		{description: "hash set", collection: NewHashSet(1, 2, 3)},
+		{description: "tree set", collection: NewTreeSet(1, 2, 3)},
+		{description: "deque", collection: NewDeque(1, 2, 3)},
+		{description: "priority queue", collection: NewPriorityQueue(1, 2, 3)},
+	}
+
+	for _, tt := range useCases {
+		err := modificationPanic(func() {
+			it := tt.collection.Iterator()
+			it.Next()
+			tt.collection.Push(4)
+			it.Next()
+		})
+		var target ErrConcurrentModification
+		if !errors.As(err, &target) {
+			t.Errorf("test: %s push while iterating want %T got %v", tt.description, target, err)
+		}
+
+		err = modificationPanic(func() {
+			it := tt.collection.Iterator()
+			it.Next()
+			tt.collection.Delete(3)
+			it.Next()
+		})
+		if !errors.As(err, &target) {
+			t.Errorf("test: %s delete while iterating want %T got %v", tt.description, target, err)
+		}
+	}
+}
+
+func TestIterator_Remove(t *testing.T) {
+	useCases := []struct {
+		description string
+		collection  Collection[int]
+		want        []int
+	}{
+		{description: "slice", collection: NewSlice(1, 2, 3, 4, 5), want: []int{1, 3, 5}},
+		{description: "linked list", collection: NewLinkedList(1, 2, 3, 4, 5), want: []int{1, 3, 5}},
+		{description: "hash set", collection: NewHashSet(1, 2, 3, 4, 5), want: []int{1, 3, 5}},
+		{description: "tree set", collection: NewTreeSet(1, 2, 3, 4, 5, 6, 7, 8), want: []int{1, 3, 5, 7}},
+		{description: "deque", collection: NewDeque(1, 2, 3, 4, 5), want: []int{1, 3, 5}},
+	}
+
+	for _, tt := range useCases {
+		for it := tt.collection.Iterator().(MutableIterator[int]); it.HasNext(); {
+			if it.Next()%2 == 0 {
+				if err := it.Remove(); err != nil {
+					t.Errorf("test: %s remove want %v got %v", tt.description, nil, err)
+				}
+			}
+		}
+		var items []int
+		for it := tt.collection.Iterator(); it.HasNext(); {
+			items = append(items, it.Next())
+		}
+		slices.Sort(items)
+		if !reflect.DeepEqual(items, tt.want) {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.want, items)
+		}
+	}
+}
+
+func TestIterator_RemoveIllegalState(t *testing.T) {
+	useCases := []struct {
+		description string
+		iterator    Iterator[int]
+		err         error
+	}{
+		{description: "remove before next", iterator: NewSlice(1).Iterator(), err: ErrIllegalIteratorState},
+		{description: "remove on empty iterator", iterator: NewLinkedList[int]().Iterator(), err: ErrIllegalIteratorState},
+		{description: "remove on priority queue", iterator: NewPriorityQueue(1).Iterator(), err: ErrUnsupportedOperation},
+		{description: "remove on synchronized list", iterator: SynchronizedList[int](NewSlice(1)).Iterator(), err: ErrUnsupportedOperation},
+		{description: "remove on blocking queue", iterator: fullBlockingQueue(1).Iterator(), err: ErrUnsupportedOperation},
+	}
+
+	for _, tt := range useCases {
+		tt.iterator.HasNext()
+		if tt.err == ErrUnsupportedOperation {
+			tt.iterator.Next()
+		}
+		err := tt.iterator.(MutableIterator[int]).Remove()
+		if err != tt.err {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.err, err)
+		}
+	}
+
+	it := NewSlice(1, 2).Iterator().(MutableIterator[int])
+	it.Next()
+	it.Remove()
+	if err := it.Remove(); err != ErrIllegalIteratorState {
+		t.Errorf("test: remove twice want %v got %v", ErrIllegalIteratorState, err)
+	}
+}
+
+func TestAll_ConcurrentModification(t *testing.T) {
+	slice := NewSlice(1, 2, 3)
+	err := modificationPanic(func() {
+		for range slice.All() {
+			slice.Push(4)
+		}
+	})
+	if _, ok := err.(ErrConcurrentModification); !ok {
+		t.Errorf("test: push while ranging want %T got %v", ErrConcurrentModification{}, err)
+	}
+}
+
+func TestListIterator(t *testing.T) {
+	lists := []struct {
+		description string
+		new         func(items ...int) List[int]
+	}{
+		{description: "slice", new: func(items ...int) List[int] { return NewSlice(items...) }},
+		{description: "linked list", new: func(items ...int) List[int] { return NewLinkedList(items...) }},
+	}
+	useCases := []struct {
+		description string
+		items       []int
+		startPos    int
+		walk        func(it ListIterator[int]) []int
+		want        []int
+		visited     []int
+	}{
+		{
+			description: "walk backward from the end",
+			items:       []int{1, 2, 3},
+			startPos:    3,
+			walk: func(it ListIterator[int]) []int {
+				var visited []int
+				for it.HasPrevious() {
+					visited = append(visited, it.Previous())
+				}
+				return visited
+			},
+			want:    []int{1, 2, 3},
+			visited: []int{3, 2, 1},
+		},
+		{
+			description: "remove forward",
+			items:       []int{1, 2, 3, 4},
+			walk: func(it ListIterator[int]) []int {
+				var visited []int
+				for it.HasNext() {
+					i, item := it.NextWithIndex()
+					visited = append(visited, i)
+					if item%2 == 0 {
+						it.Remove()
+					}
+				}
+				return visited
+			},
+			want:    []int{1, 3},
+			visited: []int{0, 1, 1, 2},
+		},
+		{
+			description: "remove backward",
+			items:       []int{1, 2, 3, 4},
+			startPos:    4,
+			walk: func(it ListIterator[int]) []int {
+				var visited []int
+				for it.HasPrevious() {
+					item := it.Previous()
+					visited = append(visited, item)
+					if item%2 == 1 {
+						it.Remove()
+					}
+				}
+				return visited
+			},
+			want:    []int{2, 4},
+			visited: []int{4, 3, 2, 1},
+		},
+		{
+			description: "set after next and previous",
+			items:       []int{1, 2, 3},
+			startPos:    1,
+			walk: func(it ListIterator[int]) []int {
+				it.Set(0)
+				it.Set(it.Next() * 10)
+				it.Previous()
+				it.Set(it.Previous() * 10)
+				return []int{it.Next()}
+			},
+			want:    []int{10, 20, 3},
+			visited: []int{10},
+		},
+		{
+			description: "add before the cursor",
+			items:       []int{1, 3},
+			walk: func(it ListIterator[int]) []int {
+				it.Add(0)
+				visited := []int{it.Next()}
+				it.Add(2)
+				visited = append(visited, it.Previous())
+				it.Next()
+				it.Next()
+				it.Add(4)
+				return append(visited, it.Previous())
+			},
+			want:    []int{0, 1, 2, 3, 4},
+			visited: []int{1, 2, 4},
+		},
+		{
+			description: "add to an empty list",
+			walk: func(it ListIterator[int]) []int {
+				it.Add(1)
+				it.Add(2)
+				return []int{it.Previous()}
+			},
+			want:    []int{1, 2},
+			visited: []int{2},
+		},
+	}
+
+	for _, l := range lists {
+		for _, tt := range useCases {
+			list := l.new(slices.Clone(tt.items)...)
+			it, err := list.ListIterator(tt.startPos)
+			if err != nil {
+				t.Errorf("test: %s %s want %v got %v", l.description, tt.description, nil, err)
+				continue
+			}
+			visited := tt.walk(it)
+			if !reflect.DeepEqual(visited, tt.visited) {
+				t.Errorf("test: %s %s visited want %v got %v", l.description, tt.description, tt.visited, visited)
+			}
+			var items []int
+			for it := list.Iterator(); it.HasNext(); {
+				items = append(items, it.Next())
+			}
+			if !reflect.DeepEqual(items, tt.want) || list.Size() != len(tt.want) {
+				t.Errorf("test: %s %s want %v got %v", l.description, tt.description, tt.want, items)
+			}
+		}
+	}
+}
+
+func TestListIterator_PreviousAtStart(t *testing.T) {
+	useCases := []struct {
+		description string
+		list        List[int]
+	}{
+		{description: "slice", list: NewSlice(1, 2)},
+		{description: "linked list", list: NewLinkedList(1, 2)},
+		{description: "empty slice", list: NewSlice[int]()},
+		{description: "empty linked list", list: NewLinkedList[int]()},
+		{description: "sub list", list: NewSlice(1, 2, 3).SubList(1, 3)},
+	}
+
+	for _, tt := range useCases {
+		it, _ := tt.list.ListIterator(0)
+		var got any
+		func() {
+			defer func() {
+				got = recover()
+			}()
+			it.Previous()
+		}()
+		if got != ErrIllegalIteratorState {
+			t.Errorf("test: %s want %v got %v", tt.description, ErrIllegalIteratorState, got)
+		}
+	}
+}
+
+func TestListIterator_Errors(t *testing.T) {
+	useCases := []struct {
+		description string
+		list        List[int]
+		startPos    int
+		err         error
+	}{
+		{description: "slice negative position", list: NewSlice(1, 2), startPos: -1, err: ErrPositionNegative},
+		{description: "slice position after the end", list: NewSlice(1, 2), startPos: 3, err: ErrIndexOutOfBound{3, 2}},
+		{description: "linked list negative position", list: NewLinkedList(1, 2), startPos: -1, err: ErrPositionNegative},
+		{description: "linked list position after the end", list: NewLinkedList(1, 2), startPos: 3, err: ErrIndexOutOfBound{3, 2}},
+	}
+
+	for _, tt := range useCases {
+		_, err := tt.list.ListIterator(tt.startPos)
+		if err != tt.err {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.err, err)
+		}
+	}
+
+	for _, list := range []List[int]{NewSlice(1, 2), NewLinkedList(1, 2)} {
+		it, _ := list.ListIterator(0)
+		it.Next()
+		it.Add(3)
+		if err := it.Set(4); err != ErrIllegalIteratorState {
+			t.Errorf("test: set after add want %v got %v", ErrIllegalIteratorState, err)
+		}
+		if err := it.Remove(); err != ErrIllegalIteratorState {
+			t.Errorf("test: remove after add want %v got %v", ErrIllegalIteratorState, err)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package collection
+
+import "cmp"
+
+// Comparator returns a negative number when a is less than b, zero when
+// they are equal and a positive number when a is greater than b.
+type Comparator[E any] func(a, b E) int
+
+// NaturalOrder returns the comparator of ordered types.
+func NaturalOrder[E cmp.Ordered]() Comparator[E] {
+	return cmp.Compare[E]
+}
+
+// ReverseOrder returns a comparator that imposes the reverse ordering of compare.
+func ReverseOrder[E any](compare Comparator[E]) Comparator[E] {
+	return func(a, b E) int {
+		return compare(b, a)
+	}
+}
@@ -0,0 +1,276 @@
+package collection
+
+// Compute and merge operations of the Map interface. HashMap, LinkedHashMap
+// and TreeMap share the helpers below built on Get, Put and Delete, the
+// operations visiting every pair walk the layout of each map directly. The
+// functions given to these methods must not change the map.
+
+func getOrDefault[K comparable, V any](m Map[K, V], key K, def V) V {
+	if v, ok := m.Get(key); ok {
+		return v
+	}
+	return def
+}
+
+func putIfAbsent[K comparable, V any](m Map[K, V], key K, value V) (V, bool) {
+	if v, ok := m.Get(key); ok {
+		return v, true
+	}
+	m.Put(key, value)
+	return value, false
+}
+
+func computeIfAbsent[K comparable, V any](m Map[K, V], key K, fn func(key K) V) V {
+	if v, ok := m.Get(key); ok {
+		return v
+	}
+	v := fn(key)
+	m.Put(key, v)
+	return v
+}
+
+func compute[K comparable, V any](m Map[K, V], key K, fn func(key K, old V, found bool) (V, bool)) (V, bool) {
+	old, found := m.Get(key)
+	v, keep := fn(key, old, found)
+	if !keep {
+		m.Delete(key)
+		return *new(V), false
+	}
+	m.Put(key, v)
+	return v, true
+}
+
+func computeIfPresent[K comparable, V any](m Map[K, V], key K, fn func(key K, old V) (V, bool)) (V, bool) {
+	return compute(m, key, func(key K, old V, found bool) (V, bool) {
+		if !found {
+			return old, false
+		}
+		return fn(key, old)
+	})
+}
+
+func merge[K comparable, V any](m Map[K, V], key K, value V, fn func(old V, value V) (V, bool)) (V, bool) {
+	return compute(m, key, func(_ K, old V, found bool) (V, bool) {
+		if !found {
+			return value, true
+		}
+		return fn(old, value)
+	})
+}
+
+func replace[K comparable, V any](m Map[K, V], key K, value V) (V, bool) {
+	old, ok := m.Get(key)
+	if ok {
+		m.Put(key, value)
+	}
+	return old, ok
+}
+
+// putAll copies the entries of other first, so that a map can be given itself.
+func putAll[K comparable, V any](m Map[K, V], other Map[K, V]) {
+	for it := other.EntryList().Iterator(); it.HasNext(); {
+		e := it.Next()
+		m.Put(e.key, e.value)
+	}
+}
+
+func (h *HashMap[K, V]) GetOrDefault(key K, def V) V {
+	return getOrDefault[K, V](h, key, def)
+}
+
+func (h *HashMap[K, V]) PutIfAbsent(key K, value V) (actual V, loaded bool) {
+	return putIfAbsent[K, V](h, key, value)
+}
+
+func (h *HashMap[K, V]) ComputeIfAbsent(key K, fn func(key K) V) V {
+	return computeIfAbsent[K, V](h, key, fn)
+}
+
+func (h *HashMap[K, V]) ComputeIfPresent(key K, fn func(key K, old V) (V, bool)) (V, bool) {
+	return computeIfPresent[K, V](h, key, fn)
+}
+
+func (h *HashMap[K, V]) Compute(key K, fn func(key K, old V, found bool) (V, bool)) (V, bool) {
+	return compute[K, V](h, key, fn)
+}
+
+func (h *HashMap[K, V]) Merge(key K, value V, fn func(old V, value V) (V, bool)) (V, bool) {
+	return merge[K, V](h, key, value, fn)
+}
+
+func (h *HashMap[K, V]) Replace(key K, value V) (old V, replaced bool) {
+	return replace[K, V](h, key, value)
+}
+
+func (h *HashMap[K, V]) ReplaceAll(fn func(key K, value V) V) {
+	for k, v := range h.table {
+		h.table[k] = fn(k, v)
+	}
+}
+
+func (h *HashMap[K, V]) ForEach(fn func(key K, value V)) {
+	for k, v := range h.table {
+		fn(k, v)
+	}
+}
+
+func (h *HashMap[K, V]) Clear() {
+	clear(h.table)
+}
+
+func (h *HashMap[K, V]) PutAll(other Map[K, V]) {
+	if o, ok := other.(*HashMap[K, V]); ok {
+		for k, v := range o.table {
+			h.table[k] = v
+		}
+		return
+	}
+	putAll[K, V](h, other)
+}
+
+func (m *LinkedHashMap[K, V]) GetOrDefault(key K, def V) V {
+	return getOrDefault[K, V](m, key, def)
+}
+
+func (m *LinkedHashMap[K, V]) PutIfAbsent(key K, value V) (actual V, loaded bool) {
+	return putIfAbsent[K, V](m, key, value)
+}
+
+func (m *LinkedHashMap[K, V]) ComputeIfAbsent(key K, fn func(key K) V) V {
+	return computeIfAbsent[K, V](m, key, fn)
+}
+
+func (m *LinkedHashMap[K, V]) ComputeIfPresent(key K, fn func(key K, old V) (V, bool)) (V, bool) {
+	return computeIfPresent[K, V](m, key, fn)
+}
+
+// Compute replaces the value of key with the one returned by fn, or removes
+// key when fn returns false. A new key goes to the back of the map.
+func (m *LinkedHashMap[K, V]) Compute(key K, fn func(key K, old V, found bool) (V, bool)) (V, bool) {
+	return compute[K, V](m, key, fn)
+}
+
+func (m *LinkedHashMap[K, V]) Merge(key K, value V, fn func(old V, value V) (V, bool)) (V, bool) {
+	return merge[K, V](m, key, value, fn)
+}
+
+func (m *LinkedHashMap[K, V]) Replace(key K, value V) (old V, replaced bool) {
+	return replace[K, V](m, key, value)
+}
+
+// ReplaceAll replaces every value with the one returned by fn in insertion order.
+func (m *LinkedHashMap[K, V]) ReplaceAll(fn func(key K, value V) V) {
+	for n := m.order.head; n != nil; n = n.next {
+		n.value = NewEntry(n.value.key, fn(n.value.key, n.value.value))
+	}
+}
+
+// ForEach calls fn with every pair of the map in insertion order.
+func (m *LinkedHashMap[K, V]) ForEach(fn func(key K, value V)) {
+	for n := m.order.head; n != nil; n = n.next {
+		fn(n.value.key, n.value.value)
+	}
+}
+
+func (m *LinkedHashMap[K, V]) Clear() {
+	clear(m.table)
+	m.order.Clear()
+}
+
+// PutAll copies the pairs of other into the map, the new keys go to the
+// back in the order of other.EntryList.
+func (m *LinkedHashMap[K, V]) PutAll(other Map[K, V]) {
+	putAll[K, V](m, other)
+}
+
+func (t *TreeMap[K, V]) GetOrDefault(key K, def V) V {
+	return getOrDefault[K, V](t, key, def)
+}
+
+// PutIfAbsent sets the value of key unless key is in the map. On views a
+// key out of range is not stored, the zero value and false are returned.
+func (t *TreeMap[K, V]) PutIfAbsent(key K, value V) (actual V, loaded bool) {
+	if !t.inRange(key) {
+		return *new(V), false
+	}
+	return putIfAbsent[K, V](t, key, value)
+}
+
+// ComputeIfAbsent sets the value of key to the one returned by fn unless
+// key is in the map. On views fn is not called for a key out of range and
+// the zero value is returned.
+func (t *TreeMap[K, V]) ComputeIfAbsent(key K, fn func(key K) V) V {
+	if !t.inRange(key) {
+		return *new(V)
+	}
+	return computeIfAbsent[K, V](t, key, fn)
+}
+
+func (t *TreeMap[K, V]) ComputeIfPresent(key K, fn func(key K, old V) (V, bool)) (V, bool) {
+	return computeIfPresent[K, V](t, key, fn)
+}
+
+// Compute replaces the value of key with the one returned by fn, or removes
+// key when fn returns false. On views fn is not called for a key out of
+// range, which is reported as not present.
+func (t *TreeMap[K, V]) Compute(key K, fn func(key K, old V, found bool) (V, bool)) (V, bool) {
+	if !t.inRange(key) {
+		return *new(V), false
+	}
+	return compute[K, V](t, key, fn)
+}
+
+// Merge sets the value of key, or combines it with the current one using
+// fn. On views a key out of range is not stored and is reported as not
+// present.
+func (t *TreeMap[K, V]) Merge(key K, value V, fn func(old V, value V) (V, bool)) (V, bool) {
+	if !t.inRange(key) {
+		return *new(V), false
+	}
+	return merge[K, V](t, key, value, fn)
+}
+
+// Replace sets the value of key only when key is in the map, it returns the
+// previous value and whether it was replaced. It looks key up only once.
+func (t *TreeMap[K, V]) Replace(key K, value V) (old V, replaced bool) {
+	if !t.inRange(key) {
+		return *new(V), false
+	}
+	n := t.tree.find(key)
+	if n == nil {
+		return *new(V), false
+	}
+	old, n.value = n.value, value
+	return old, true
+}
+
+// ReplaceAll replaces every value with the one returned by fn in ascending order of the keys.
+func (t *TreeMap[K, V]) ReplaceAll(fn func(key K, value V) V) {
+	for n := range t.nodes() {
+		n.value = fn(n.key, n.value)
+	}
+}
+
+// ForEach calls fn with every pair of the map in ascending order of the keys.
+func (t *TreeMap[K, V]) ForEach(fn func(key K, value V)) {
+	for n := range t.nodes() {
+		fn(n.key, n.value)
+	}
+}
+
+// Clear deletes every entry of the map, on views only the entries in range.
+func (t *TreeMap[K, V]) Clear() {
+	if t.lo != nil || t.hi != nil {
+		for n := t.lowest(); n != nil && !t.tooHigh(n.key); n = t.lowest() {
+			t.tree.deleteNode(n)
+		}
+		return
+	}
+	t.tree.root = nil
+	t.tree.size = 0
+	t.tree.mods++
+}
+
+func (t *TreeMap[K, V]) PutAll(other Map[K, V]) {
+	putAll[K, V](t, other)
+}
@@ -0,0 +1,218 @@
+package collection
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// computeMaps builds every map kind from the given pairs.
+var computeMaps = []struct {
+	name string
+	new  func(entries ...*Entry[string, int]) Map[string, int]
+}{
+	{name: "hash map", new: func(entries ...*Entry[string, int]) Map[string, int] { return NewHashMap(entries...) }},
+	{name: "linked hash map", new: func(entries ...*Entry[string, int]) Map[string, int] { return NewLinkedHashMap(entries...) }},
+	{name: "tree map", new: func(entries ...*Entry[string, int]) Map[string, int] { return NewTreeMap(entries...) }},
+	{name: "concurrent hash map", new: func(entries ...*Entry[string, int]) Map[string, int] { return NewConcurrentHashMap(entries...) }},
+	{name: "synchronized map", new: func(entries ...*Entry[string, int]) Map[string, int] {
+		return SynchronizedMap[string, int](NewHashMap(entries...))
+	}},
+}
+
+// mapContent returns the pairs of m as "key=value" sorted by key.
+func mapContent(m Map[string, int]) []string {
+	var pairs []string
+	m.ForEach(func(key string, value int) {
+		pairs = append(pairs, key+"="+string(rune('0'+value)))
+	})
+	slices.Sort(pairs)
+	return pairs
+}
+
+func TestMap_Compute(t *testing.T) {
+	double := func(key string, old int) (int, bool) { return old * 2, true }
+	drop := func(key string, old int) (int, bool) { return 0, false }
+	sum := func(old, value int) (int, bool) { return old + value, true }
+
+	useCases := []struct {
+		description string
+		change      func(m Map[string, int]) (int, bool)
+		wantValue   int
+		wantOk      bool
+		want        []string
+	}{
+		{description: "get or default of a present key", change: func(m Map[string, int]) (int, bool) { return m.GetOrDefault("a", 9), true }, wantValue: 1, wantOk: true, want: []string{"a=1", "b=2"}},
+		{description: "get or default of a missing key", change: func(m Map[string, int]) (int, bool) { return m.GetOrDefault("c", 9), true }, wantValue: 9, wantOk: true, want: []string{"a=1", "b=2"}},
+		{description: "put if absent of a present key", change: func(m Map[string, int]) (int, bool) { return m.PutIfAbsent("a", 5) }, wantValue: 1, wantOk: true, want: []string{"a=1", "b=2"}},
+		{description: "put if absent of a missing key", change: func(m Map[string, int]) (int, bool) { return m.PutIfAbsent("c", 5) }, wantValue: 5, wantOk: false, want: []string{"a=1", "b=2", "c=5"}},
+		{description: "compute if absent of a present key", change: func(m Map[string, int]) (int, bool) {
+			return m.ComputeIfAbsent("a", func(key string) int { return 7 }), true
+		}, wantValue: 1, wantOk: true, want: []string{"a=1", "b=2"}},
+		{description: "compute if absent of a missing key", change: func(m Map[string, int]) (int, bool) {
+			return m.ComputeIfAbsent("c", func(key string) int { return len(key) }), true
+		}, wantValue: 1, wantOk: true, want: []string{"a=1", "b=2", "c=1"}},
+		{description: "compute if present of a present key", change: func(m Map[string, int]) (int, bool) { return m.ComputeIfPresent("b", double) }, wantValue: 4, wantOk: true, want: []string{"a=1", "b=4"}},
+		{description: "compute if present of a missing key", change: func(m Map[string, int]) (int, bool) { return m.ComputeIfPresent("c", double) }, wantValue: 0, wantOk: false, want: []string{"a=1", "b=2"}},
+		{description: "compute if present removing the key", change: func(m Map[string, int]) (int, bool) { return m.ComputeIfPresent("a", drop) }, wantValue: 0, wantOk: false, want: []string{"b=2"}},
+		{description: "compute of a missing key", change: func(m Map[string, int]) (int, bool) {
+			return m.Compute("c", func(key string, old int, found bool) (int, bool) { return old + 3, !found })
+		}, wantValue: 3, wantOk: true, want: []string{"a=1", "b=2", "c=3"}},
+		{description: "compute removing the key", change: func(m Map[string, int]) (int, bool) {
+			return m.Compute("b", func(key string, old int, found bool) (int, bool) { return 0, false })
+		}, wantValue: 0, wantOk: false, want: []string{"a=1"}},
+		{description: "merge of a present key", change: func(m Map[string, int]) (int, bool) { return m.Merge("b", 3, sum) }, wantValue: 5, wantOk: true, want: []string{"a=1", "b=5"}},
+		{description: "merge of a missing key", change: func(m Map[string, int]) (int, bool) { return m.Merge("c", 3, sum) }, wantValue: 3, wantOk: true, want: []string{"a=1", "b=2", "c=3"}},
+		{description: "merge removing the key", change: func(m Map[string, int]) (int, bool) {
+			return m.Merge("a", 1, func(old, value int) (int, bool) { return 0, false })
+		}, wantValue: 0, wantOk: false, want: []string{"b=2"}},
+		{description: "replace of a present key", change: func(m Map[string, int]) (int, bool) { return m.Replace("a", 8) }, wantValue: 1, wantOk: true, want: []string{"a=8", "b=2"}},
+		{description: "replace of a missing key", change: func(m Map[string, int]) (int, bool) { return m.Replace("c", 8) }, wantValue: 0, wantOk: false, want: []string{"a=1", "b=2"}},
+		{description: "replace all", change: func(m Map[string, int]) (int, bool) {
+			m.ReplaceAll(func(key string, value int) int { return value + 3 })
+			return m.Size(), true
+		}, wantValue: 2, wantOk: true, want: []string{"a=4", "b=5"}},
+		{description: "clear", change: func(m Map[string, int]) (int, bool) {
+			m.Clear()
+			return m.Size(), m.Empty()
+		}, wantValue: 0, wantOk: true, want: nil},
+		{description: "put all", change: func(m Map[string, int]) (int, bool) {
+			m.PutAll(NewTreeMap(NewEntry("b", 5), NewEntry("c", 6)))
+			return m.Size(), true
+		}, wantValue: 3, wantOk: true, want: []string{"a=1", "b=5", "c=6"}},
+		{description: "put all of itself", change: func(m Map[string, int]) (int, bool) {
+			m.PutAll(m)
+			return m.Size(), true
+		}, wantValue: 2, wantOk: true, want: []string{"a=1", "b=2"}},
+	}
+
+	for _, f := range computeMaps {
+		for _, tt := range useCases {
+			m := f.new(NewEntry("a", 1), NewEntry("b", 2))
+			value, ok := tt.change(m)
+			result := mapContent(m)
+			if value != tt.wantValue || ok != tt.wantOk || !slices.Equal(result, tt.want) || m.Size() != len(tt.want) {
+				t.Errorf("test: %s %s want (%v, %v, %v) got (%v, %v, %v)", f.name, tt.description, tt.wantValue, tt.wantOk, tt.want, value, ok, result)
+			}
+		}
+	}
+}
+
+func TestMap_ComputeCounting(t *testing.T) {
+	words := strings.Fields("the cat and the dog and the bird")
+	for _, f := range computeMaps {
+		m := f.new()
+		for _, w := range words {
+			m.Merge(w, 1, func(old, value int) (int, bool) { return old + value, true })
+		}
+		if result := mapContent(m); !slices.Equal(result, []string{"and=2", "bird=1", "cat=1", "dog=1", "the=3"}) {
+			t.Errorf("test: %s count words want %v got %v", f.name, []string{"and=2", "bird=1", "cat=1", "dog=1", "the=3"}, result)
+		}
+	}
+}
+
+func TestMap_ForEachOrder(t *testing.T) {
+	useCases := []struct {
+		description string
+		table       Map[string, int]
+		want        []string
+	}{
+		{description: "linked hash map in insertion order", table: NewLinkedHashMap(NewEntry("c", 1), NewEntry("a", 2), NewEntry("b", 3)), want: []string{"c", "a", "b"}},
+		{description: "tree map in key order", table: NewTreeMap(NewEntry("c", 1), NewEntry("a", 2), NewEntry("b", 3)), want: []string{"a", "b", "c"}},
+	}
+
+	for _, tt := range useCases {
+		tt.table.Compute("d", func(key string, old int, found bool) (int, bool) { return 4, true })
+		tt.table.ReplaceAll(func(key string, value int) int { return value * 10 })
+		var result []string
+		tt.table.ForEach(func(key string, value int) {
+			result = append(result, key)
+		})
+		want := append(tt.want, "d")
+		if !slices.Equal(result, want) {
+			t.Errorf("test: %s want %v got %v", tt.description, want, result)
+		}
+	}
+}
+
+func TestLinkedHashMap_ReplaceAllKeepsEntries(t *testing.T) {
+	m := NewLinkedHashMap(NewEntry("a", 1))
+	e, _ := m.Front()
+	m.ReplaceAll(func(key string, value int) int { return value + 1 })
+	if e.Value() != 1 {
+		t.Errorf("test: entry handed out before replace all want %v got %v", 1, e.Value())
+	}
+	if v, _ := m.Get("a"); v != 2 {
+		t.Errorf("test: value after replace all want %v got %v", 2, v)
+	}
+}
+
+func TestTreeMap_Clear(t *testing.T) {
+	m := NewTreeMap(NewEntry("a", 1), NewEntry("b", 2))
+	keys := m.Keys()
+	m.Clear()
+	m.Put("c", 3)
+	if result := m.Keys().Size(); result != 1 || keys.Size() != 2 {
+		t.Errorf("test: clear want (1, 2) got (%v, %v)", result, keys.Size())
+	}
+	if f, err := m.FirstKey(); err != nil || f != "c" {
+		t.Errorf("test: first key after clear want %v got %v", "c", f)
+	}
+}
+
+func TestTreeMap_ComputeView(t *testing.T) {
+	m := NewTreeMap(NewEntry(5, "a"))
+	view := m.HeadMap(10)
+	called := false
+
+	if v, ok := view.Compute(20, func(int, string, bool) (string, bool) { called = true; return "x", true }); ok || v != "" {
+		t.Errorf("test: compute out of range want (%q, %v) got (%q, %v)", "", false, v, ok)
+	}
+	if v, ok := view.PutIfAbsent(30, "y"); ok || v != "" {
+		t.Errorf("test: put if absent out of range want (%q, %v) got (%q, %v)", "", false, v, ok)
+	}
+	if v := view.ComputeIfAbsent(40, func(int) string { called = true; return "x" }); v != "" {
+		t.Errorf("test: compute if absent out of range want %q got %q", "", v)
+	}
+	if v, ok := view.Merge(50, "z", func(old, value string) (string, bool) { called = true; return value, true }); ok || v != "" {
+		t.Errorf("test: merge out of range want (%q, %v) got (%q, %v)", "", false, v, ok)
+	}
+	if called || m.Size() != 1 || view.Size() != 1 {
+		t.Errorf("test: out of range keys want (false, 1) got (%v, %v)", called, m.Size())
+	}
+
+	if v, ok := view.Merge(5, "b", func(old, value string) (string, bool) { return old + value, true }); !ok || v != "ab" {
+		t.Errorf("test: merge in range want (%q, %v) got (%q, %v)", "ab", true, v, ok)
+	}
+	if v, ok := view.PutIfAbsent(7, "c"); ok || v != "c" || m.Size() != 2 {
+		t.Errorf("test: put if absent in range want (%q, %v) got (%q, %v)", "c", false, v, ok)
+	}
+}
+
+func TestConcurrentHashMap_ComputeIfPresent(t *testing.T) {
+	m := NewConcurrentHashMapWithShards[int, int](4)
+	for i := range stressOperations {
+		m.Put(i, 0)
+	}
+	var wg sync.WaitGroup
+	for range stressGoroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range stressOperations {
+				m.ComputeIfPresent(i, func(key, old int) (int, bool) { return old + 1, true })
+			}
+		}()
+	}
+	wg.Wait()
+	for i := range stressOperations {
+		if v, _ := m.Get(i); v != stressGoroutines {
+			t.Errorf("test: concurrent compute if present of %v want %v got %v", i, stressGoroutines, v)
+		}
+	}
+	m.Clear()
+	if m.Size() != 0 || !m.Empty() {
+		t.Errorf("test: clear want %v got %v", 0, m.Size())
+	}
+}
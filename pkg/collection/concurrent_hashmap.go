@@ -0,0 +1,320 @@
+package collection
+
+import (
+	"fmt"
+	"hash/maphash"
+	"iter"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+const defaultConcurrentHashMapShards = 32
+
+// concurrentShard is one lock stripe of a ConcurrentHashMap.
+type concurrentShard[K comparable, V any] struct {
+	mu    sync.RWMutex
+	table map[K]V
+}
+
+// ConcurrentHashMap is a Map safe for concurrent use, keys are spread over
+// shards each guarded by its own lock so that writers of different shards
+// do not wait for each other. Iterating over the map is weakly consistent:
+// every shard is seen in a consistent state but not all of them at the same
+// time.
+type ConcurrentHashMap[K comparable, V any] struct {
+	shards []*concurrentShard[K, V]
+	seed   maphash.Seed
+	size   atomic.Int64
+	equal  EqualFunc[V]
+}
+
+func NewConcurrentHashMap[K comparable, V any](entries ...*Entry[K, V]) *ConcurrentHashMap[K, V] {
+	m := NewConcurrentHashMapWithShards[K, V](defaultConcurrentHashMapShards)
+	for _, e := range entries {
+		m.Put(e.key, e.value)
+	}
+	return m
+}
+
+// NewConcurrentHashMapWithShards creates an empty map split in shards lock
+// stripes, the number is rounded up to the next power of two, and configured
+// with the given value options.
+func NewConcurrentHashMapWithShards[K comparable, V any](shards int, opts ...Option[V]) *ConcurrentHashMap[K, V] {
+	n := 1
+	for n < shards {
+		n <<= 1
+	}
+	m := &ConcurrentHashMap[K, V]{shards: make([]*concurrentShard[K, V], n), seed: maphash.MakeSeed(), equal: newOptions(opts...).equal}
+	for i := range m.shards {
+		m.shards[i] = &concurrentShard[K, V]{table: make(map[K]V)}
+	}
+	return m
+}
+
+func (m *ConcurrentHashMap[K, V]) eq() EqualFunc[V] {
+	if m.equal == nil {
+		return DefaultEqual[V]()
+	}
+	return m.equal
+}
+
+func (m *ConcurrentHashMap[K, V]) shard(key K) *concurrentShard[K, V] {
+	h := maphash.Comparable(m.seed, key)
+	return m.shards[h&uint64(len(m.shards)-1)]
+}
+
+// All returns a sequence over the pairs of the map in random order, the
+// pairs of each shard are copied under its read lock before being yielded
+// so the map can be changed while ranging.
+func (m *ConcurrentHashMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, s := range m.shards {
+			s.mu.RLock()
+			entries := make([]*Entry[K, V], 0, len(s.table))
+			for k, v := range s.table {
+				entries = append(entries, NewEntry(k, v))
+			}
+			s.mu.RUnlock()
+			for _, e := range entries {
+				if !yield(e.key, e.value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (m *ConcurrentHashMap[K, V]) Empty() bool {
+	return m.Size() == 0
+}
+
+func (m *ConcurrentHashMap[K, V]) Size() int {
+	return int(m.size.Load())
+}
+
+func (m *ConcurrentHashMap[K, V]) Get(key K) (V, bool) {
+	s := m.shard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	v, ok := s.table[key]
+	return v, ok
+}
+
+func (m *ConcurrentHashMap[K, V]) Put(key K, value V) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m.store(s, key, value)
+}
+
+// store sets the value of key in s, the lock of s must be held.
+func (m *ConcurrentHashMap[K, V]) store(s *concurrentShard[K, V], key K, value V) {
+	if _, ok := s.table[key]; !ok {
+		m.size.Add(1)
+	}
+	s.table[key] = value
+}
+
+// remove deletes key from s, the lock of s must be held.
+func (m *ConcurrentHashMap[K, V]) remove(s *concurrentShard[K, V], key K) bool {
+	if _, ok := s.table[key]; !ok {
+		return false
+	}
+	delete(s.table, key)
+	m.size.Add(-1)
+	return true
+}
+
+func (m *ConcurrentHashMap[K, V]) ContainsKey(key K) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+func (m *ConcurrentHashMap[K, V]) ContainsValue(value V) bool {
+	eq := m.eq()
+	for _, v := range m.All() {
+		if eq(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *ConcurrentHashMap[K, V]) Delete(key K) bool {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return m.remove(s, key)
+}
+
+// PutIfAbsent stores value only when key is not in the map, it returns the
+// value now associated to key and whether it was already there.
+func (m *ConcurrentHashMap[K, V]) PutIfAbsent(key K, value V) (actual V, loaded bool) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v, ok := s.table[key]; ok {
+		return v, true
+	}
+	m.store(s, key, value)
+	return value, false
+}
+
+// ComputeIfAbsent returns the value of key, when key is not in the map the
+// value is computed by fn and stored first. fn runs under the lock of the
+// shard of key so it must not use the map.
+func (m *ConcurrentHashMap[K, V]) ComputeIfAbsent(key K, fn func(key K) V) V {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v, ok := s.table[key]; ok {
+		return v
+	}
+	v := fn(key)
+	m.store(s, key, v)
+	return v
+}
+
+// Compute replaces the value of key with the one returned by fn, which gets
+// the current value and whether key is in the map. When fn returns false
+// the key is removed. Compute returns the new value and whether key is in
+// the map afterwards. fn runs under the lock of the shard of key so it must
+// not use the map.
+func (m *ConcurrentHashMap[K, V]) Compute(key K, fn func(key K, old V, found bool) (V, bool)) (V, bool) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	old, found := s.table[key]
+	v, keep := fn(key, old, found)
+	if !keep {
+		m.remove(s, key)
+		return *new(V), false
+	}
+	m.store(s, key, v)
+	return v, true
+}
+
+// Merge stores value when key is not in the map, otherwise it replaces the
+// current value with the one returned by fn or removes key when fn returns
+// false. Merge returns the new value and whether key is in the map
+// afterwards. fn runs under the lock of the shard of key so it must not use
+// the map.
+func (m *ConcurrentHashMap[K, V]) Merge(key K, value V, fn func(old V, value V) (V, bool)) (V, bool) {
+	return m.Compute(key, func(_ K, old V, found bool) (V, bool) {
+		if !found {
+			return value, true
+		}
+		return fn(old, value)
+	})
+}
+
+func (m *ConcurrentHashMap[K, V]) GetOrDefault(key K, def V) V {
+	if v, ok := m.Get(key); ok {
+		return v
+	}
+	return def
+}
+
+// ComputeIfPresent replaces the value of key with the one returned by fn or
+// removes key when fn returns false, nothing happens when key is not in the
+// map. fn runs under the lock of the shard of key so it must not use the map.
+func (m *ConcurrentHashMap[K, V]) ComputeIfPresent(key K, fn func(key K, old V) (V, bool)) (V, bool) {
+	return m.Compute(key, func(key K, old V, found bool) (V, bool) {
+		if !found {
+			return old, false
+		}
+		return fn(key, old)
+	})
+}
+
+// Replace sets the value of key only when key is in the map, it returns the
+// previous value and whether it was replaced.
+func (m *ConcurrentHashMap[K, V]) Replace(key K, value V) (old V, replaced bool) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	old, replaced = s.table[key]
+	if replaced {
+		s.table[key] = value
+	}
+	return old, replaced
+}
+
+// ReplaceAll replaces every value with the one returned by fn, one shard at
+// a time under its lock so fn must not use the map.
+func (m *ConcurrentHashMap[K, V]) ReplaceAll(fn func(key K, value V) V) {
+	for _, s := range m.shards {
+		s.mu.Lock()
+		for k, v := range s.table {
+			s.table[k] = fn(k, v)
+		}
+		s.mu.Unlock()
+	}
+}
+
+// ForEach calls fn with every pair of the map, it is weakly consistent like
+// All and no lock is held while fn runs.
+func (m *ConcurrentHashMap[K, V]) ForEach(fn func(key K, value V)) {
+	for k, v := range m.All() {
+		fn(k, v)
+	}
+}
+
+// Clear empties the shards one after the other, pairs put meanwhile in a
+// shard already cleared are kept.
+func (m *ConcurrentHashMap[K, V]) Clear() {
+	for _, s := range m.shards {
+		s.mu.Lock()
+		m.size.Add(-int64(len(s.table)))
+		clear(s.table)
+		s.mu.Unlock()
+	}
+}
+
+// PutAll copies the pairs of other into the map.
+func (m *ConcurrentHashMap[K, V]) PutAll(other Map[K, V]) {
+	for it := other.EntryList().Iterator(); it.HasNext(); {
+		e := it.Next()
+		m.Put(e.key, e.value)
+	}
+}
+
+func (m *ConcurrentHashMap[K, V]) Keys() Set[K] {
+	set := NewHashSet[K]()
+	for k := range m.All() {
+		set.Push(k)
+	}
+	return set
+}
+
+func (m *ConcurrentHashMap[K, V]) Values() Collection[V] {
+	lst := NewSlice[V]()
+	for _, v := range m.All() {
+		lst.PushBack(v)
+	}
+	return lst
+}
+
+func (m *ConcurrentHashMap[K, V]) EntryList() Collection[*Entry[K, V]] {
+	lst := NewSlice[*Entry[K, V]]()
+	for k, v := range m.All() {
+		lst.PushBack(NewEntry(k, v))
+	}
+	return lst
+}
+
+func (m *ConcurrentHashMap[K, V]) String() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	first := true
+	for k, v := range m.All() {
+		if !first {
+			sb.WriteString(", ")
+		}
+		first = false
+		sb.WriteString(fmt.Sprintf("%v", NewEntry(k, v)))
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
@@ -0,0 +1,198 @@
+package collection
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestConcurrentHashMap_Put(t *testing.T) {
+	useCases := []struct {
+		description string
+		table       *ConcurrentHashMap[int, string]
+		key         int
+		value       string
+		size        int
+	}{
+		{description: "put in empty map", table: NewConcurrentHashMap[int, string](), key: 1, value: "one", size: 1},
+		{description: "put new key", table: NewConcurrentHashMap(NewEntry(1, "one")), key: 2, value: "two", size: 2},
+		{description: "replace value", table: NewConcurrentHashMap(NewEntry(1, "one")), key: 1, value: "uno", size: 1},
+	}
+
+	for _, tt := range useCases {
+		tt.table.Put(tt.key, tt.value)
+		value, ok := tt.table.Get(tt.key)
+		if !ok || value != tt.value {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.value, value)
+		}
+		if tt.table.Size() != tt.size {
+			t.Errorf("test: %s size want %v got %v", tt.description, tt.size, tt.table.Size())
+		}
+	}
+}
+
+func TestConcurrentHashMap_Delete(t *testing.T) {
+	useCases := []struct {
+		description string
+		table       *ConcurrentHashMap[int, string]
+		key         int
+		want        bool
+		size        int
+	}{
+		{description: "delete from empty map", table: NewConcurrentHashMap[int, string](), key: 1, want: false, size: 0},
+		{description: "delete missing key", table: NewConcurrentHashMap(NewEntry(1, "one")), key: 2, want: false, size: 1},
+		{description: "delete key", table: NewConcurrentHashMap(NewEntry(1, "one"), NewEntry(2, "two")), key: 1, want: true, size: 1},
+	}
+
+	for _, tt := range useCases {
+		result := tt.table.Delete(tt.key)
+		if result != tt.want {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.want, result)
+		}
+		if tt.table.Size() != tt.size || tt.table.ContainsKey(tt.key) {
+			t.Errorf("test: %s size want %v got %v", tt.description, tt.size, tt.table.Size())
+		}
+	}
+}
+
+func TestConcurrentHashMap_PutIfAbsent(t *testing.T) {
+	useCases := []struct {
+		description string
+		table       *ConcurrentHashMap[int, string]
+		key         int
+		value       string
+		want        string
+		loaded      bool
+	}{
+		{description: "absent key", table: NewConcurrentHashMap[int, string](), key: 1, value: "one", want: "one", loaded: false},
+		{description: "present key", table: NewConcurrentHashMap(NewEntry(1, "one")), key: 1, value: "uno", want: "one", loaded: true},
+	}
+
+	for _, tt := range useCases {
+		actual, loaded := tt.table.PutIfAbsent(tt.key, tt.value)
+		if actual != tt.want || loaded != tt.loaded {
+			t.Errorf("test: %s want (%v, %v) got (%v, %v)", tt.description, tt.want, tt.loaded, actual, loaded)
+		}
+		if value, _ := tt.table.Get(tt.key); value != tt.want {
+			t.Errorf("test: %s stored want %v got %v", tt.description, tt.want, value)
+		}
+	}
+}
+
+func TestConcurrentHashMap_Compute(t *testing.T) {
+	increment := func(_ string, old int, found bool) (int, bool) {
+		return old + 1, true
+	}
+	drop := func(_ string, old int, found bool) (int, bool) {
+		return 0, false
+	}
+	useCases := []struct {
+		description string
+		table       *ConcurrentHashMap[string, int]
+		fn          func(key string, old int, found bool) (int, bool)
+		want        int
+		present     bool
+	}{
+		{description: "compute absent key", table: NewConcurrentHashMap[string, int](), fn: increment, want: 1, present: true},
+		{description: "compute present key", table: NewConcurrentHashMap(NewEntry("a", 1)), fn: increment, want: 2, present: true},
+		{description: "remove present key", table: NewConcurrentHashMap(NewEntry("a", 1)), fn: drop, want: 0, present: false},
+		{description: "remove absent key", table: NewConcurrentHashMap[string, int](), fn: drop, want: 0, present: false},
+	}
+
+	for _, tt := range useCases {
+		value, present := tt.table.Compute("a", tt.fn)
+		if value != tt.want || present != tt.present {
+			t.Errorf("test: %s want (%v, %v) got (%v, %v)", tt.description, tt.want, tt.present, value, present)
+		}
+		if tt.table.ContainsKey("a") != tt.present || tt.table.Size() != tt.table.Keys().Size() {
+			t.Errorf("test: %s contains want %v got %v", tt.description, tt.present, tt.table.ContainsKey("a"))
+		}
+	}
+}
+
+func TestConcurrentHashMap_Merge(t *testing.T) {
+	sum := func(old, value int) (int, bool) {
+		return old + value, old+value != 0
+	}
+	useCases := []struct {
+		description string
+		table       *ConcurrentHashMap[string, int]
+		value       int
+		want        int
+		present     bool
+	}{
+		{description: "merge absent key", table: NewConcurrentHashMap[string, int](), value: 3, want: 3, present: true},
+		{description: "merge present key", table: NewConcurrentHashMap(NewEntry("a", 1)), value: 3, want: 4, present: true},
+		{description: "merge to removal", table: NewConcurrentHashMap(NewEntry("a", 1)), value: -1, want: 0, present: false},
+	}
+
+	for _, tt := range useCases {
+		value, present := tt.table.Merge("a", tt.value, sum)
+		if value != tt.want || present != tt.present {
+			t.Errorf("test: %s want (%v, %v) got (%v, %v)", tt.description, tt.want, tt.present, value, present)
+		}
+	}
+}
+
+func TestConcurrentHashMap_Shards(t *testing.T) {
+	useCases := []struct {
+		description string
+		shards      int
+		want        int
+	}{
+		{description: "no shards", shards: 0, want: 1},
+		{description: "power of two", shards: 16, want: 16},
+		{description: "rounded up", shards: 17, want: 32},
+	}
+
+	for _, tt := range useCases {
+		m := NewConcurrentHashMapWithShards[int, int](tt.shards)
+		if len(m.shards) != tt.want {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.want, len(m.shards))
+		}
+	}
+}
+
+func TestConcurrentHashMap_Stress(t *testing.T) {
+	m := NewConcurrentHashMapWithShards[int, int](4)
+	var computed atomic.Int64
+
+	var wg sync.WaitGroup
+	for g := 0; g < stressGoroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < stressOperations; i++ {
+				key := i % 100
+				m.Merge(key, 1, func(old, value int) (int, bool) {
+					return old + value, true
+				})
+				m.ComputeIfAbsent(-key-1, func(int) int {
+					computed.Add(1)
+					return key
+				})
+				m.PutIfAbsent(stressOperations+key, g)
+				m.Get(key)
+				if i%10 == 0 {
+					for range m.All() {
+						break
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	for key := 0; key < 100; key++ {
+		want := stressGoroutines * stressOperations / 100
+		if value, _ := m.Get(key); value != want {
+			t.Errorf("test: merged counter %d want %v got %v", key, want, value)
+		}
+	}
+	if computed.Load() != 100 {
+		t.Errorf("test: compute if absent calls want %v got %v", 100, computed.Load())
+	}
+	if m.Size() != 300 || m.Keys().Size() != 300 {
+		t.Errorf("test: size want %v got %v", 300, m.Size())
+	}
+}
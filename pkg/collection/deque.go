@@ -0,0 +1,343 @@
+package collection
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+)
+
+const dequeBlockSize = 64
+
+// Deque is a double-ended queue backed by a ring of fixed size blocks, items
+// can be pushed and popped at both ends and read by index in amortised O(1).
+// When the ring is full only the block pointers are moved, items stay where
+// they are. Once at most a quarter of it is used the ring is halved.
+type Deque[E any] struct {
+	blocks [][]E
+	head   int
+	size   int
+	equal  EqualFunc[E]
+	mods   int
+}
+
+func NewDeque[E any](items ...E) *Deque[E] {
+	d := &Deque[E]{}
+	for _, item := range items {
+		d.PushBack(item)
+	}
+	return d
+}
+
+// NewDequeWith creates a deque configured with the given options, it holds
+// the items given by WithItems.
+func NewDequeWith[E any](opts ...Option[E]) *Deque[E] {
+	o := newOptions(opts...)
+	d := &Deque[E]{equal: o.equal}
+	for _, item := range o.items {
+		d.PushBack(item)
+	}
+	return d
+}
+
+func (d *Deque[E]) eq() EqualFunc[E] {
+	if d.equal == nil {
+		return DefaultEqual[E]()
+	}
+	return d.equal
+}
+
+func (d *Deque[E]) capacity() int {
+	return len(d.blocks) * dequeBlockSize
+}
+
+// slot returns the address of the i-th item of the deque, blocks are allocated on first use.
+func (d *Deque[E]) slot(i int) *E {
+	p := (d.head + i) % d.capacity()
+	b := p / dequeBlockSize
+	if d.blocks[b] == nil {
+		d.blocks[b] = make([]E, dequeBlockSize)
+	}
+	return &d.blocks[b][p%dequeBlockSize]
+}
+
+// grow doubles the number of blocks of the ring rotating it so that the block
+// holding the head comes first, items that wrapped around the end of the
+// head block are moved to the first new block.
+func (d *Deque[E]) grow() {
+	n := len(d.blocks)
+	if n == 0 {
+		d.blocks = make([][]E, 1)
+		d.head = 0
+		return
+	}
+	hb, ho := d.head/dequeBlockSize, d.head%dequeBlockSize
+	blocks := make([][]E, 2*n)
+	for i := 0; i < n; i++ {
+		blocks[i] = d.blocks[(hb+i)%n]
+	}
+	if ho > 0 && d.size > 0 {
+		blocks[n] = make([]E, dequeBlockSize)
+		copy(blocks[n], blocks[0][:ho])
+		clear(blocks[0][:ho])
+	}
+	d.blocks = blocks
+	d.head = ho
+}
+
+// shrink halves the number of blocks of the ring while at most a quarter of
+// it is used, like Queue does. The items are moved to the first blocks of
+// the new ring so that the spare blocks are released.
+func (d *Deque[E]) shrink() {
+	n := len(d.blocks)
+	for n > 1 && d.size <= n*dequeBlockSize/4 {
+		n /= 2
+	}
+	if n == len(d.blocks) {
+		return
+	}
+	blocks := make([][]E, n)
+	for i := 0; i < d.size; i++ {
+		b := i / dequeBlockSize
+		if blocks[b] == nil {
+			blocks[b] = make([]E, dequeBlockSize)
+		}
+		blocks[b][i%dequeBlockSize] = *d.slot(i)
+	}
+	d.blocks = blocks
+	d.head = 0
+}
+
+func (d *Deque[E]) Iterator() Iterator[E] {
+	if d.Empty() {
+		return &emptyListIterator[E]{}
+	}
+	return &dequeIterator[E]{deque: d, last: -1, expected: d.mods}
+}
+
+// ReverseIterator returns an iterator from the back to the front of the deque.
+func (d *Deque[E]) ReverseIterator() Iterator[E] {
+	if d.Empty() {
+		return &emptyListIterator[E]{}
+	}
+	return &dequeIterator[E]{deque: d, reverse: true, last: -1, expected: d.mods}
+}
+
+// All returns a sequence over the items of the deque from the front to the back.
+func (d *Deque[E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		mods := d.mods
+		for i := 0; i < d.size; i++ {
+			if !yield(*d.slot(i)) {
+				return
+			}
+			checkModifications(mods, d.mods)
+		}
+	}
+}
+
+// Enumerate returns a sequence over the items of the deque from the front to the back along with their position.
+func (d *Deque[E]) Enumerate() iter.Seq2[int, E] {
+	return enumerate(d.All())
+}
+
+func (d *Deque[E]) Empty() bool {
+	return d.Size() == 0
+}
+
+func (d *Deque[E]) Size() int {
+	return d.size
+}
+
+func (d *Deque[E]) Push(item E) {
+	d.PushBack(item)
+}
+
+// PushBack adds an item at the back of the deque.
+func (d *Deque[E]) PushBack(item E) {
+	if d.size == d.capacity() {
+		d.grow()
+	}
+	*d.slot(d.size) = item
+	d.size++
+	d.mods++
+}
+
+// PushFront adds an item at the front of the deque.
+func (d *Deque[E]) PushFront(item E) {
+	if d.size == d.capacity() {
+		d.grow()
+	}
+	d.head = (d.head - 1 + d.capacity()) % d.capacity()
+	*d.slot(0) = item
+	d.size++
+	d.mods++
+}
+
+// PopFront get and remove the item at the front of the deque, but
+// if deque is empty well this method return ErrEmptyCollection error.
+func (d *Deque[E]) PopFront() (E, error) {
+	if d.Empty() {
+		return *new(E), ErrEmptyCollection
+	}
+	slot := d.slot(0)
+	item := *slot
+	*slot = *new(E)
+	d.head = (d.head + 1) % d.capacity()
+	d.size--
+	d.mods++
+	d.shrink()
+	return item, nil
+}
+
+// PopBack get and remove the item at the back of the deque, but
+// if deque is empty well this method return ErrEmptyCollection error.
+func (d *Deque[E]) PopBack() (E, error) {
+	if d.Empty() {
+		return *new(E), ErrEmptyCollection
+	}
+	slot := d.slot(d.size - 1)
+	item := *slot
+	*slot = *new(E)
+	d.size--
+	d.mods++
+	d.shrink()
+	return item, nil
+}
+
+// PeekFront get the item at the front of the deque, but
+// if deque is empty well this method return ErrEmptyCollection error.
+func (d *Deque[E]) PeekFront() (E, error) {
+	return d.GetAt(0)
+}
+
+// PeekBack get the item at the back of the deque, but
+// if deque is empty well this method return ErrEmptyCollection error.
+func (d *Deque[E]) PeekBack() (E, error) {
+	return d.GetAt(d.size - 1)
+}
+
+func (d *Deque[E]) GetAt(pos int) (E, error) {
+	if d.Empty() {
+		return *new(E), ErrEmptyCollection
+	}
+	if pos < 0 {
+		return *new(E), ErrPositionNegative
+	}
+	if pos >= d.size {
+		return *new(E), ErrIndexOutOfBound{pos, d.size}
+	}
+	return *d.slot(pos), nil
+}
+
+func (d *Deque[E]) Contains(item E) bool {
+	_, err := d.Index(item)
+	return err == nil
+}
+
+func (d *Deque[E]) Index(item E) (int, error) {
+	eq := d.eq()
+	for i := 0; i < d.size; i++ {
+		if eq(*d.slot(i), item) {
+			return i, nil
+		}
+	}
+	return 0, ErrItemNotFound{item}
+}
+
+func (d *Deque[E]) Delete(item E) error {
+	pos, err := d.Index(item)
+	if err != nil {
+		return err
+	}
+	return d.DeleteAt(pos)
+}
+
+// DeleteAt removes the item at the given position shifting the shorter side of the deque.
+func (d *Deque[E]) DeleteAt(pos int) error {
+	if d.Empty() {
+		return ErrEmptyCollection
+	}
+	if pos < 0 {
+		return ErrPositionNegative
+	}
+	if pos >= d.size {
+		return ErrIndexOutOfBound{pos, d.size}
+	}
+	if pos < d.size/2 {
+		for i := pos; i > 0; i-- {
+			*d.slot(i) = *d.slot(i - 1)
+		}
+		_, err := d.PopFront()
+		return err
+	}
+	for i := pos; i < d.size-1; i++ {
+		*d.slot(i) = *d.slot(i + 1)
+	}
+	_, err := d.PopBack()
+	return err
+}
+
+func (d *Deque[E]) String() string {
+	var sb strings.Builder
+	sb.WriteString("[")
+	for i := 0; i < d.Size(); i++ {
+		var s string
+		item := *d.slot(i)
+		if i >= d.Size()-1 {
+			s = fmt.Sprintf("%v", item)
+		} else {
+			s = fmt.Sprintf("%v, ", item)
+		}
+		sb.WriteString(s)
+	}
+	sb.WriteString("]")
+	return sb.String()
+}
+
+type dequeIterator[E any] struct {
+	deque    *Deque[E]
+	index    int
+	reverse  bool
+	last     int
+	expected int
+}
+
+// position returns the position in the deque of the i-th item visited by the iterator.
+func (it *dequeIterator[E]) position(i int) int {
+	if it.reverse {
+		return it.deque.size - 1 - i
+	}
+	return i
+}
+
+func (it *dequeIterator[E]) HasNext() bool {
+	return it.index < it.deque.size
+}
+
+func (it *dequeIterator[E]) Next() E {
+	_, item := it.NextWithIndex()
+	return item
+}
+
+func (it *dequeIterator[E]) NextWithIndex() (int, E) {
+	checkModifications(it.expected, it.deque.mods)
+	index := it.index
+	it.last = index
+	it.index++
+	return index, *it.deque.slot(it.position(index))
+}
+
+// Remove deletes the item returned by the last call to Next.
+func (it *dequeIterator[E]) Remove() error {
+	if it.last < 0 {
+		return ErrIllegalIteratorState
+	}
+	checkModifications(it.expected, it.deque.mods)
+	if err := it.deque.DeleteAt(it.position(it.last)); err != nil {
+		return err
+	}
+	it.index = it.last
+	it.last = -1
+	it.expected = it.deque.mods
+	return nil
+}
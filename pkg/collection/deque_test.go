@@ -0,0 +1,245 @@
+package collection
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDeque_Size(t *testing.T) {
+	useCases := []struct {
+		description string
+		deque       *Deque[int]
+		want        int
+	}{
+		{description: "empty deque", deque: NewDeque[int](), want: 0},
+		{description: "deque with items", deque: NewDeque(1, 2, 3), want: 3},
+	}
+
+	for _, tt := range useCases {
+		result := tt.deque.Size()
+		if result != tt.want || tt.deque.Empty() != (tt.want == 0) {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.want, result)
+		}
+	}
+}
+
+func TestDeque_Push(t *testing.T) {
+	d := NewDeque[int]()
+	var want []int
+	for i := 0; i < 500; i++ {
+		if i%3 == 0 {
+			d.PushFront(i)
+			want = append([]int{i}, want...)
+		} else {
+			d.PushBack(i)
+			want = append(want, i)
+		}
+	}
+	if result := itemsOf(d.Iterator()); !reflect.DeepEqual(result, want) {
+		t.Errorf("test: push at both ends want %v got %v", want, result)
+	}
+	for i, item := range want {
+		if result, err := d.GetAt(i); result != item || err != nil {
+			t.Fatalf("test: get at %d want {%v, %v} got {%v, %v}", i, item, nil, result, err)
+		}
+	}
+}
+
+func TestDeque_Pop(t *testing.T) {
+	d := NewDeque[int]()
+	var want []int
+	next := 0
+	for round := 0; round < 300; round++ {
+		for i := 0; i < round%5+1; i++ {
+			if round%2 == 0 {
+				d.PushBack(next)
+				want = append(want, next)
+			} else {
+				d.PushFront(next)
+				want = append([]int{next}, want...)
+			}
+			next++
+		}
+		for i := 0; i < round%4 && len(want) > 0; i++ {
+			var item, expected int
+			var err error
+			if i%2 == 0 {
+				item, err = d.PopFront()
+				expected, want = want[0], want[1:]
+			} else {
+				item, err = d.PopBack()
+				expected, want = want[len(want)-1], want[:len(want)-1]
+			}
+			if item != expected || err != nil {
+				t.Fatalf("test: pop in round %d want {%v, %v} got {%v, %v}", round, expected, nil, item, err)
+			}
+		}
+		if result := itemsOf(d.Iterator()); d.Size() != len(want) || (len(want) > 0 && !reflect.DeepEqual(result, want)) {
+			t.Fatalf("test: items in round %d want %v got %v", round, want, result)
+		}
+	}
+}
+
+func TestDeque_Shrink(t *testing.T) {
+	useCases := []struct {
+		description string
+		pop         func(d *Deque[int]) (int, error)
+		first       func(n int) int
+	}{
+		{description: "pop front", pop: (*Deque[int]).PopFront, first: func(n int) int { return 0 }},
+		{description: "pop back", pop: (*Deque[int]).PopBack, first: func(n int) int { return n - 1 }},
+	}
+
+	const n = 100 * dequeBlockSize
+	for _, tt := range useCases {
+		deque := NewDeque[int]()
+		for i := 0; i < n; i++ {
+			deque.PushBack(i)
+		}
+		if item, _ := tt.pop(deque); item != tt.first(n) {
+			t.Errorf("test: %s first item want %v got %v", tt.description, tt.first(n), item)
+		}
+		for i := 1; i < n-1; i++ {
+			tt.pop(deque)
+		}
+		if len(deque.blocks) != 1 || deque.Size() != 1 {
+			t.Errorf("test: %s blocks left want %v got %v", tt.description, 1, len(deque.blocks))
+		}
+		if item, _ := deque.PeekFront(); item != n-1-tt.first(n) {
+			t.Errorf("test: %s last item want %v got %v", tt.description, n-1-tt.first(n), item)
+		}
+	}
+
+	filtered := NewDeque[int]()
+	for i := 0; i < n; i++ {
+		filtered.PushBack(i)
+	}
+	if filtered.RemoveIf(func(x int) bool { return x > 0 }); len(filtered.blocks) != 1 {
+		t.Errorf("test: remove if blocks left want %v got %v", 1, len(filtered.blocks))
+	}
+
+	wrapped := NewDeque[int]()
+	for i := 0; i < 5*dequeBlockSize; i++ {
+		wrapped.PushFront(i)
+	}
+	for i := 0; i < 4*dequeBlockSize; i++ {
+		wrapped.PopBack()
+	}
+	for i := 5*dequeBlockSize - 1; i >= 4*dequeBlockSize; i-- {
+		if item, _ := wrapped.PopFront(); item != i {
+			t.Fatalf("test: order after shrinking a wrapped ring want %v got %v", i, item)
+		}
+	}
+}
+
+func TestDeque_Peek(t *testing.T) {
+	useCases := []struct {
+		description string
+		deque       *Deque[int]
+		front       int
+		back        int
+		err         error
+	}{
+		{description: "peek on empty deque", deque: NewDeque[int](), err: ErrEmptyCollection},
+		{description: "peek on deque with items", deque: NewDeque(1, 2, 3), front: 1, back: 3},
+	}
+
+	for _, tt := range useCases {
+		front, err1 := tt.deque.PeekFront()
+		back, err2 := tt.deque.PeekBack()
+		if front != tt.front || back != tt.back || err1 != tt.err || err2 != tt.err {
+			t.Errorf("test: %s want {%v, %v, %v} got {%v, %v, %v}", tt.description, tt.front, tt.back, tt.err, front, back, err1)
+		}
+	}
+
+	empty := NewDeque[int]()
+	if _, err := empty.PopFront(); err != ErrEmptyCollection {
+		t.Errorf("test: pop front on empty deque want %v got %v", ErrEmptyCollection, err)
+	}
+	if _, err := empty.PopBack(); err != ErrEmptyCollection {
+		t.Errorf("test: pop back on empty deque want %v got %v", ErrEmptyCollection, err)
+	}
+}
+
+func TestDeque_GetAt(t *testing.T) {
+	useCases := []struct {
+		description string
+		deque       *Deque[int]
+		pos         int
+		item        int
+		err         error
+	}{
+		{description: "get item on empty deque", deque: NewDeque[int](), pos: 0, item: 0, err: ErrEmptyCollection},
+		{description: "get item with out of bound index", deque: NewDeque(1, 2, 3), pos: 5, item: 0, err: ErrIndexOutOfBound{5, 3}},
+		{description: "get item with negative index", deque: NewDeque(1, 2, 3), pos: -1, item: 0, err: ErrPositionNegative},
+		{description: "get item in middle position", deque: NewDeque(1, 2, 3), pos: 1, item: 2, err: nil},
+	}
+
+	for _, tt := range useCases {
+		result, err := tt.deque.GetAt(tt.pos)
+		if result != tt.item || err != tt.err {
+			t.Errorf("test: %s want {%v, %v} got {%v, %v}", tt.description, tt.item, tt.err, result, err)
+		}
+	}
+}
+
+func TestDeque_Delete(t *testing.T) {
+	useCases := []struct {
+		description string
+		deque       *Deque[int]
+		item        int
+		want        string
+		err         error
+	}{
+		{description: "delete missing item", deque: NewDeque(1, 2, 3), item: 4, want: "[1, 2, 3]", err: ErrItemNotFound{4}},
+		{description: "delete item near the front", deque: NewDeque(1, 2, 3, 4, 5), item: 2, want: "[1, 3, 4, 5]", err: nil},
+		{description: "delete item near the back", deque: NewDeque(1, 2, 3, 4, 5), item: 4, want: "[1, 2, 3, 5]", err: nil},
+	}
+
+	for _, tt := range useCases {
+		err := tt.deque.Delete(tt.item)
+		if tt.deque.String() != tt.want || err != tt.err || tt.deque.Contains(tt.item) {
+			t.Errorf("test: %s want {%v, %v} got {%v, %v}", tt.description, tt.want, tt.err, tt.deque, err)
+		}
+	}
+}
+
+func TestDeque_ReverseIterator(t *testing.T) {
+	useCases := []struct {
+		description string
+		deque       *Deque[int]
+		want        []int
+	}{
+		{description: "empty deque", deque: NewDeque[int](), want: nil},
+		{description: "deque with items", deque: NewDeque(1, 2, 3), want: []int{3, 2, 1}},
+	}
+
+	for _, tt := range useCases {
+		result := itemsOf(tt.deque.ReverseIterator())
+		if !reflect.DeepEqual(result, tt.want) {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.want, result)
+		}
+	}
+}
+
+func BenchmarkDeque_PushPopFront(b *testing.B) {
+	d := NewDeque[int]()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		d.PushFront(i)
+	}
+	for i := 0; i < b.N; i++ {
+		d.PopFront()
+	}
+}
+
+func BenchmarkDeque_PushPopBack(b *testing.B) {
+	d := NewDeque[int]()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		d.PushBack(i)
+	}
+	for i := 0; i < b.N; i++ {
+		d.PopBack()
+	}
+}
@@ -0,0 +1,104 @@
+package collection
+
+import (
+	"reflect"
+	"sync"
+)
+
+// Equaler is implemented by types that define their own notion of equality,
+// such as time.Time.
+type Equaler[E any] interface {
+	Equal(other E) bool
+}
+
+// EqualFunc reports whether a and b must be considered equal.
+type EqualFunc[E any] func(a, b E) bool
+
+// Option configures a collection created by one of the New*With constructors.
+type Option[E any] func(*options[E])
+
+type options[E any] struct {
+	equal EqualFunc[E]
+	items []E
+}
+
+// WithEqual sets the function used by lookup and delete methods to compare items.
+func WithEqual[E any](fn EqualFunc[E]) Option[E] {
+	return func(o *options[E]) {
+		o.equal = fn
+	}
+}
+
+// WithItems sets the items a list, queue or vector starts with, so that
+// NewSliceWith(WithEqual(fn), WithItems(items...)) is NewSlice(items...)
+// comparing items with fn. Constructors of maps ignore it.
+func WithItems[E any](items ...E) Option[E] {
+	return func(o *options[E]) {
+		o.items = append(o.items, items...)
+	}
+}
+
+func newOptions[E any](opts ...Option[E]) *options[E] {
+	o := &options[E]{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.equal == nil {
+		o.equal = DefaultEqual[E]()
+	}
+	return o
+}
+
+// defaultEquals caches the function built by DefaultEqual for every type,
+// so that collections without an equality function do not walk the type on
+// every comparison.
+var defaultEquals sync.Map // reflect.Type -> EqualFunc[E]
+
+// DefaultEqual returns the equality function used when none is given.
+// Types implementing Equaler are compared with their Equal method, comparable
+// types holding neither interfaces nor pointers with == and every other type
+// with reflect.DeepEqual, so pointers are equal when their targets are.
+func DefaultEqual[E any]() EqualFunc[E] {
+	t := reflect.TypeFor[E]()
+	if fn, ok := defaultEquals.Load(t); ok {
+		return fn.(EqualFunc[E])
+	}
+	fn := newDefaultEqual[E](t)
+	defaultEquals.Store(t, fn)
+	return fn
+}
+
+func newDefaultEqual[E any](t reflect.Type) EqualFunc[E] {
+	if _, ok := any(*new(E)).(Equaler[E]); ok {
+		return func(a, b E) bool {
+			return any(a).(Equaler[E]).Equal(b)
+		}
+	}
+	if t.Comparable() && !needsDeepEqual(t) {
+		return func(a, b E) bool {
+			return any(a) == any(b)
+		}
+	}
+	return func(a, b E) bool {
+		return reflect.DeepEqual(a, b)
+	}
+}
+
+// needsDeepEqual reports whether == does not match reflect.DeepEqual on a
+// comparable type: interfaces may hold an uncomparable dynamic value, which
+// panics, and pointers are compared by address instead of by target.
+func needsDeepEqual(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Interface, reflect.Pointer:
+		return true
+	case reflect.Array:
+		return needsDeepEqual(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if needsDeepEqual(t.Field(i).Type) {
+				return true
+			}
+		}
+	}
+	return false
+}
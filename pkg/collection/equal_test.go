@@ -0,0 +1,105 @@
+package collection
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDefaultEqual(t *testing.T) {
+	now := time.Now()
+	useCases := []struct {
+		description string
+		equal       func() bool
+		want        bool
+	}{
+		{description: "equal ints", equal: func() bool { return DefaultEqual[int]()(1, 1) }, want: true},
+		{description: "different ints", equal: func() bool { return DefaultEqual[int]()(1, 2) }, want: false},
+		{description: "equal slices", equal: func() bool { return DefaultEqual[[]int]()([]int{1, 2}, []int{1, 2}) }, want: true},
+		{description: "uncomparable values in interface", equal: func() bool { return DefaultEqual[any]()([]int{1}, []int{1}) }, want: true},
+		{description: "pointers to equal values", equal: func() bool { a, b := 1, 1; return DefaultEqual[*int]()(&a, &b) }, want: true},
+		{description: "pointers to different values", equal: func() bool { a, b := 1, 2; return DefaultEqual[*int]()(&a, &b) }, want: false},
+		{description: "structs holding pointers to equal values", equal: func() bool {
+			type node struct{ next *int }
+			a, b := 1, 1
+			return DefaultEqual[node]()(node{&a}, node{&b})
+		}, want: true},
+		{description: "equaler with different location", equal: func() bool { return DefaultEqual[time.Time]()(now, now.UTC()) }, want: true},
+	}
+
+	for _, tt := range useCases {
+		result := tt.equal()
+		if result != tt.want {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.want, result)
+		}
+	}
+}
+
+func TestWithEqual(t *testing.T) {
+	fold := WithEqual(strings.EqualFold)
+
+	slice := NewSliceWith(fold)
+	slice.Push("Hello")
+	if !slice.Contains("HELLO") {
+		t.Errorf("test: slice contains want %v got %v", true, false)
+	}
+	if i, err := slice.Index("hello"); i != 0 || err != nil {
+		t.Errorf("test: slice index want {%v, %v} got {%v, %v}", 0, nil, i, err)
+	}
+	if err := slice.Delete("hElLo"); err != nil || !slice.Empty() {
+		t.Errorf("test: slice delete want %v got %v", NewSlice[string](), slice)
+	}
+
+	lst := NewLinkedListWith(fold)
+	lst.Push("Hello")
+	if !lst.Contains("HELLO") {
+		t.Errorf("test: linked list contains want %v got %v", true, false)
+	}
+	if err := lst.Delete("hElLo"); err != nil || !lst.Empty() {
+		t.Errorf("test: linked list delete want %v got %v", NewLinkedList[string](), lst)
+	}
+
+	table := NewHashMapWith[int](fold)
+	table.Put(1, "Hello")
+	if !table.ContainsValue("HELLO") {
+		t.Errorf("test: hash map contains value want %v got %v", true, false)
+	}
+
+	useCases := []struct {
+		description string
+		contains    func(item string) bool
+	}{
+		{description: "seeded slice", contains: NewSliceWith(fold, WithItems("Hello")).Contains},
+		{description: "seeded linked list", contains: NewLinkedListWith(fold, WithItems("Hello")).Contains},
+		{description: "seeded deque", contains: NewDequeWith(fold, WithItems("Hello")).Contains},
+		{description: "priority queue", contains: NewPriorityQueueWith(strings.Compare, fold, WithItems("b", "Hello", "a")).Contains},
+		{description: "blocking queue", contains: NewBlockingQueue(1, fold, WithItems("Hello")).Contains},
+		{description: "persistent vector", contains: NewPersistentVectorWith(fold, WithItems("Hello")).Append("a").Contains},
+		{description: "tree map", contains: func(item string) bool {
+			m := NewTreeMapWith[int](NaturalOrder[int](), fold)
+			m.Put(1, "Hello")
+			return m.HeadMap(2).ContainsValue(item)
+		}},
+		{description: "concurrent hash map", contains: func(item string) bool {
+			m := NewConcurrentHashMapWithShards[int](2, fold)
+			m.Put(1, "Hello")
+			return m.ContainsValue(item)
+		}},
+		{description: "list multimap", contains: func(item string) bool {
+			m := NewListMultiMapWith[int](fold, WithItems("a"))
+			m.Put(1, "Hello")
+			return m.ContainsValue(item) && m.Size() == 1
+		}},
+	}
+
+	for _, tt := range useCases {
+		if !tt.contains("HELLO") {
+			t.Errorf("test: %s contains want %v got %v", tt.description, true, false)
+		}
+	}
+
+	if slice := NewSliceWith(WithItems(1, 2), WithItems(3)); !reflect.DeepEqual(slice.ToSlice(), []int{1, 2, 3}) {
+		t.Errorf("test: with items want %v got %v", []int{1, 2, 3}, slice)
+	}
+}
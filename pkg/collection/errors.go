@@ -6,6 +6,9 @@ var (
 	ErrPositionNegative = fmt.Errorf("position can not be negative")
 	ErrEmptyCollection  = fmt.Errorf("this collection is empty")
 	ErrNodeNotFound     = fmt.Errorf("node not found")
+
+	ErrIllegalIteratorState = fmt.Errorf("iterator has no current item")
+	ErrUnsupportedOperation = fmt.Errorf("operation not supported")
 )
 
 type ErrIndexOutOfBound struct {
@@ -24,3 +27,23 @@ type ErrItemNotFound struct {
 func (e ErrItemNotFound) Error() string {
 	return fmt.Sprintf("item %v not found", e.item)
 }
+
+// ErrConcurrentModification is the panic value of an iterator whose
+// collection has been structurally modified by something else than the
+// iterator itself.
+type ErrConcurrentModification struct {
+	expected int
+	actual   int
+}
+
+func (e ErrConcurrentModification) Error() string {
+	return fmt.Sprintf("collection modified during iteration, expected %d modifications got %d", e.expected, e.actual)
+}
+
+// checkModifications panics with ErrConcurrentModification when the
+// modification counter of a collection moved away from the expected one.
+func checkModifications(expected, actual int) {
+	if expected != actual {
+		panic(ErrConcurrentModification{expected, actual})
+	}
+}
@@ -0,0 +1,254 @@
+package collection
+
+import (
+	"bytes"
+	"encoding/gob"
+	"iter"
+	"maps"
+	"slices"
+)
+
+// Collections are gob encoded as the slice of their items in iteration
+// order and maps as the slices of their keys and of their values. Decoding
+// replaces the content of the collection, like UnmarshalJSON does. A map
+// encoding with not as many keys as values gives ErrInvalidEncoding.
+
+type gobPairs[K any, V any] struct {
+	Keys   []K
+	Values []V
+}
+
+func gobEncode(value any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(value); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func gobDecode(data []byte, value any) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(value)
+}
+
+func gobEncodeItems[E any](seq iter.Seq[E]) ([]byte, error) {
+	return gobEncode(slices.Collect(seq))
+}
+
+func gobDecodeItems[E any](data []byte) ([]E, error) {
+	var items []E
+	if err := gobDecode(data, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func gobEncodeMap[K any, V any](seq iter.Seq2[K, V]) ([]byte, error) {
+	var pairs gobPairs[K, V]
+	for k, v := range seq {
+		pairs.Keys = append(pairs.Keys, k)
+		pairs.Values = append(pairs.Values, v)
+	}
+	return gobEncode(pairs)
+}
+
+func gobDecodeMap[K any, V any](data []byte, put func(key K, value V)) error {
+	var pairs gobPairs[K, V]
+	if err := gobDecode(data, &pairs); err != nil {
+		return err
+	}
+	if len(pairs.Keys) != len(pairs.Values) {
+		return ErrInvalidEncoding
+	}
+	for i, k := range pairs.Keys {
+		put(k, pairs.Values[i])
+	}
+	return nil
+}
+
+func (s *Slice[E]) GobEncode() ([]byte, error) {
+	return gobEncode(s.inner)
+}
+
+func (s *Slice[E]) GobDecode(data []byte) error {
+	items, err := gobDecodeItems[E](data)
+	if err != nil {
+		return err
+	}
+	return s.load(items)
+}
+
+func (l *LinkedList[E]) GobEncode() ([]byte, error) {
+	return gobEncodeItems(l.All())
+}
+
+func (l *LinkedList[E]) GobDecode(data []byte) error {
+	items, err := gobDecodeItems[E](data)
+	if err != nil {
+		return err
+	}
+	return l.load(items)
+}
+
+func (s *Stack[E]) GobEncode() ([]byte, error) {
+	return gobEncode(s.items)
+}
+
+func (s *Stack[E]) GobDecode(data []byte) error {
+	items, err := gobDecodeItems[E](data)
+	if err != nil {
+		return err
+	}
+	return s.load(items)
+}
+
+func (q *Queue[E]) GobEncode() ([]byte, error) {
+	return gobEncodeItems(q.All())
+}
+
+func (q *Queue[E]) GobDecode(data []byte) error {
+	items, err := gobDecodeItems[E](data)
+	if err != nil {
+		return err
+	}
+	return q.load(items)
+}
+
+func (d *Deque[E]) GobEncode() ([]byte, error) {
+	return gobEncodeItems(d.All())
+}
+
+func (d *Deque[E]) GobDecode(data []byte) error {
+	items, err := gobDecodeItems[E](data)
+	if err != nil {
+		return err
+	}
+	return d.load(items)
+}
+
+// GobEncode encodes the items of the queue in the order they would be popped.
+func (q *PriorityQueue[E]) GobEncode() ([]byte, error) {
+	return gobEncode(q.sorted())
+}
+
+// GobDecode replaces the items of the queue, but if the queue has not
+// been created by its constructor well this method return ErrMissingComparator error.
+func (q *PriorityQueue[E]) GobDecode(data []byte) error {
+	if q.compare == nil {
+		return ErrMissingComparator
+	}
+	items, err := gobDecodeItems[E](data)
+	if err != nil {
+		return err
+	}
+	return q.load(items)
+}
+
+func (h *HashSet[E]) GobEncode() ([]byte, error) {
+	return gobEncodeItems(h.All())
+}
+
+func (h *HashSet[E]) GobDecode(data []byte) error {
+	items, err := gobDecodeItems[E](data)
+	if err != nil {
+		return err
+	}
+	return h.load(items)
+}
+
+func (s *TreeSet[E]) GobEncode() ([]byte, error) {
+	return gobEncodeItems(s.All())
+}
+
+// GobDecode replaces the items of the set, items out of the range of a
+// view are ignored. If the set has not been created by its constructor
+// well this method return ErrMissingComparator error.
+func (s *TreeSet[E]) GobDecode(data []byte) error {
+	if s.tree == nil {
+		return ErrMissingComparator
+	}
+	items, err := gobDecodeItems[E](data)
+	if err != nil {
+		return err
+	}
+	return s.load(items)
+}
+
+func (h *HashMap[K, V]) GobEncode() ([]byte, error) {
+	return gobEncodeMap(h.All())
+}
+
+func (h *HashMap[K, V]) GobDecode(data []byte) error {
+	table := make(map[K]V)
+	err := gobDecodeMap(data, func(key K, value V) {
+		table[key] = value
+	})
+	if err != nil {
+		return err
+	}
+	h.table = table
+	return nil
+}
+
+func (t *TreeMap[K, V]) GobEncode() ([]byte, error) {
+	return gobEncodeMap(t.All())
+}
+
+// GobDecode replaces the entries of the map, keys out of the range of a
+// view are ignored. If the map has not been created by its constructor
+// well this method return ErrMissingComparator error.
+func (t *TreeMap[K, V]) GobDecode(data []byte) error {
+	if t.tree == nil {
+		return ErrMissingComparator
+	}
+	var entries []*Entry[K, V]
+	err := gobDecodeMap(data, func(key K, value V) {
+		entries = append(entries, NewEntry(key, value))
+	})
+	if err != nil {
+		return err
+	}
+	t.load(entries)
+	return nil
+}
+
+// GobEncode encodes a snapshot of the queue in FIFO order.
+func (q *BlockingQueue[E]) GobEncode() ([]byte, error) {
+	return gobEncode(q.snapshot())
+}
+
+func (q *BlockingQueue[E]) GobDecode(data []byte) error {
+	items, err := gobDecodeItems[E](data)
+	if err != nil {
+		return err
+	}
+	return q.load(items)
+}
+
+// GobEncode encodes a snapshot of the map taken with every shard locked.
+func (m *ConcurrentHashMap[K, V]) GobEncode() ([]byte, error) {
+	return gobEncodeMap(maps.All(m.snapshot()))
+}
+
+func (m *ConcurrentHashMap[K, V]) GobDecode(data []byte) error {
+	table := make(map[K]V)
+	err := gobDecodeMap(data, func(key K, value V) {
+		table[key] = value
+	})
+	if err != nil {
+		return err
+	}
+	return m.load(table)
+}
+
+func (e Entry[K, V]) GobEncode() ([]byte, error) {
+	return gobEncode(entryFields[K, V]{e.key, e.value})
+}
+
+func (e *Entry[K, V]) GobDecode(data []byte) error {
+	var entry entryFields[K, V]
+	if err := gobDecode(data, &entry); err != nil {
+		return err
+	}
+	e.key, e.value = entry.Key, entry.Value
+	return nil
+}
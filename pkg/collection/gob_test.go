@@ -0,0 +1,82 @@
+package collection
+
+import (
+	"bytes"
+	"encoding/gob"
+	"reflect"
+	"testing"
+)
+
+func gobRoundTrip(value any, target any) error {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(value); err != nil {
+		return err
+	}
+	return gob.NewDecoder(&buf).Decode(target)
+}
+
+func TestGob(t *testing.T) {
+	useCases := []struct {
+		description string
+		value       any
+		target      any
+		want        any
+	}{
+		{description: "slice", value: NewSlice(1, 2, 3), target: NewSlice[int](), want: []int{1, 2, 3}},
+		{description: "empty slice", value: NewSlice[int](), target: NewSlice(9), want: []int(nil)},
+		{description: "linked list", value: NewLinkedList("a", "b"), target: &LinkedList[string]{}, want: []string{"a", "b"}},
+		{description: "stack", value: NewStack(1, 2, 3), target: &Stack[int]{}, want: []int{1, 2, 3}},
+		{description: "queue", value: dequeued(NewQueue(1, 2, 3, 4)), target: &Queue[int]{}, want: []int{2, 3, 4}},
+		{description: "deque", value: frontPushed(NewDeque(2, 3)), target: NewDeque(9), want: []int{1, 2, 3}},
+		{description: "priority queue", value: NewPriorityQueue(3, 1, 2), target: NewPriorityQueue[int](), want: []int{1, 2, 3}},
+		{description: "hash set", value: NewHashSet(2, 1), target: &HashSet[int]{}, want: []int{1, 2}},
+		{description: "tree set", value: NewTreeSet(3, 1, 2), target: NewTreeSet(9), want: []int{1, 2, 3}},
+		{description: "hash map", value: NewHashMap(NewEntry("a", 1), NewEntry("b", 2)), target: &HashMap[string, int]{}, want: map[string]int{"a": 1, "b": 2}},
+		{description: "tree map", value: NewTreeMap(NewEntry("a", 1), NewEntry("b", 2)), target: NewTreeMap[string, int](), want: map[string]int{"a": 1, "b": 2}},
+		{description: "entry", value: NewEntry("a", 1), target: &Entry[string, int]{}, want: map[string]int{"a": 1}},
+		{description: "blocking queue", value: fullBlockingQueue(3), target: NewBlockingQueue[int](1), want: []int{0, 1, 2}},
+		{description: "zero blocking queue", value: fullBlockingQueue(2), target: &BlockingQueue[int]{}, want: []int{0, 1}},
+		{description: "concurrent hash map", value: NewConcurrentHashMap(NewEntry("a", 1), NewEntry("b", 2)), target: NewConcurrentHashMap(NewEntry("z", 0)), want: map[string]int{"a": 1, "b": 2}},
+		{description: "zero concurrent hash map", value: NewConcurrentHashMap(NewEntry("a", 1)), target: &ConcurrentHashMap[string, int]{}, want: map[string]int{"a": 1}},
+	}
+
+	for _, tt := range useCases {
+		if err := gobRoundTrip(tt.value, tt.target); err != nil {
+			t.Errorf("test: %s want %v got %v", tt.description, nil, err)
+			continue
+		}
+		got := jsonContent(tt.target)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.want, got)
+		}
+	}
+}
+
+func TestGob_Errors(t *testing.T) {
+	useCases := []struct {
+		description string
+		value       any
+		target      any
+		err         error
+	}{
+		{description: "zero priority queue", value: NewPriorityQueue(1), target: &PriorityQueue[int]{}, err: ErrMissingComparator},
+		{description: "zero tree set", value: NewTreeSet(1), target: &TreeSet[int]{}, err: ErrMissingComparator},
+		{description: "zero tree map", value: NewTreeMap(NewEntry(1, 1)), target: &TreeMap[int, int]{}, err: ErrMissingComparator},
+	}
+
+	for _, tt := range useCases {
+		err := gobRoundTrip(tt.value, tt.target)
+		if err != tt.err {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.err, err)
+		}
+	}
+
+	data, _ := gobEncode(gobPairs[string, int]{Keys: []string{"a", "b"}, Values: []int{1}})
+	if err := NewHashMap[string, int]().GobDecode(data); err != ErrInvalidEncoding {
+		t.Errorf("test: more keys than values want %v got %v", ErrInvalidEncoding, err)
+	}
+
+	if err := gobRoundTrip(NewSlice("a"), NewSlice[int]()); err == nil {
+		t.Errorf("test: decode strings into ints want an error got %v", err)
+	}
+}
@@ -2,12 +2,13 @@ package collection
 
 import (
 	"fmt"
-	"reflect"
+	"iter"
 	"strings"
 )
 
 type HashMap[K comparable, V any] struct {
 	table map[K]V
+	equal EqualFunc[V]
 }
 
 type Entry[K comparable, V any] struct {
@@ -43,7 +44,30 @@ func NewHashMap[K comparable, V any](entries ...*Entry[K, V]) *HashMap[K, V] {
 	for _, e := range entries {
 		m[e.key] = e.value
 	}
-	return &HashMap[K, V]{m}
+	return &HashMap[K, V]{table: m}
+}
+
+// NewHashMapWith creates an empty hash map configured with the given value options.
+func NewHashMapWith[K comparable, V any](opts ...Option[V]) *HashMap[K, V] {
+	return &HashMap[K, V]{table: make(map[K]V), equal: newOptions(opts...).equal}
+}
+
+func (h *HashMap[K, V]) eq() EqualFunc[V] {
+	if h.equal == nil {
+		return DefaultEqual[V]()
+	}
+	return h.equal
+}
+
+// All returns a sequence over the pairs of the map in random order.
+func (h *HashMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range h.table {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
 }
 
 func (h *HashMap[K, V]) Empty() bool {
@@ -69,8 +93,9 @@ func (h *HashMap[K, V]) ContainsKey(key K) bool {
 }
 
 func (h *HashMap[K, V]) ContainsValue(value V) bool {
+	eq := h.eq()
 	for _, v := range h.table {
-		if reflect.DeepEqual(v, value) {
+		if eq(v, value) {
 			return true
 		}
 	}
@@ -2,11 +2,13 @@ package collection
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 )
 
 type HashSet[E comparable] struct {
 	inner map[E]bool
+	mods  int
 }
 
 func NewHashSet[E comparable](items ...E) *HashSet[E] {
@@ -14,16 +16,37 @@ func NewHashSet[E comparable](items ...E) *HashSet[E] {
 	for _, item := range items {
 		inner[item] = true
 	}
-	return &HashSet[E]{inner}
+	return &HashSet[E]{inner: inner}
 }
 
 func (h *HashSet[E]) Iterator() Iterator[E] {
 	// FIXME this is only hack for iterate over map
-	items := NewSlice[E]()
+	items := make([]E, 0, len(h.inner))
 	for k := range h.inner {
-		items.Push(k)
+		items = append(items, k)
 	}
-	return items.Iterator()
+	return newSliceIterator(&items, &h.mods, func(pos int) {
+		// order does not matter, so the last item fills the hole
+		h.Delete(items[pos])
+		items[pos] = items[len(items)-1]
+		items = items[:len(items)-1]
+	})
+}
+
+// All returns a sequence over the items of the set in random order.
+func (h *HashSet[E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for item := range h.inner {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate returns a sequence over the items of the set in random order along with their position.
+func (h *HashSet[E]) Enumerate() iter.Seq2[int, E] {
+	return enumerate(h.All())
 }
 
 func (h *HashSet[E]) Empty() bool {
@@ -35,7 +58,10 @@ func (h *HashSet[E]) Size() int {
 }
 
 func (h *HashSet[E]) Push(item E) {
-	h.inner[item] = true
+	if !h.inner[item] {
+		h.inner[item] = true
+		h.mods++
+	}
 }
 
 func (h *HashSet[E]) Contains(item E) bool {
@@ -43,10 +69,88 @@ func (h *HashSet[E]) Contains(item E) bool {
 }
 
 func (h *HashSet[E]) Delete(item E) error {
-	delete(h.inner, item)
+	if h.inner[item] {
+		delete(h.inner, item)
+		h.mods++
+	}
 	return nil
 }
 
+// UnionUpdate adds to the set every item of other.
+func (h *HashSet[E]) UnionUpdate(other Set[E]) {
+	n := len(h.inner)
+	for it := other.Iterator(); it.HasNext(); {
+		h.inner[it.Next()] = true
+	}
+	if len(h.inner) != n {
+		h.mods++
+	}
+}
+
+// IntersectionUpdate keeps in the set only the items that are also in other,
+// it iterates over the smaller of the two sets.
+func (h *HashSet[E]) IntersectionUpdate(other Set[E]) {
+	n := len(h.inner)
+	if h.Size() <= other.Size() {
+		for item := range h.inner {
+			if !other.Contains(item) {
+				delete(h.inner, item)
+			}
+		}
+		if len(h.inner) != n {
+		h.mods++
+	}
+		return
+	}
+	inner := make(map[E]bool)
+	for it := other.Iterator(); it.HasNext(); {
+		item := it.Next()
+		if h.inner[item] {
+			inner[item] = true
+		}
+	}
+	h.inner = inner
+	if len(h.inner) != n {
+		h.mods++
+	}
+}
+
+// DifferenceUpdate removes from the set every item of other.
+func (h *HashSet[E]) DifferenceUpdate(other Set[E]) {
+	n := len(h.inner)
+	if h.Size() < other.Size() {
+		for item := range h.inner {
+			if other.Contains(item) {
+				delete(h.inner, item)
+			}
+		}
+	} else {
+		for it := other.Iterator(); it.HasNext(); {
+			delete(h.inner, it.Next())
+		}
+	}
+	if len(h.inner) != n {
+		h.mods++
+	}
+}
+
+// SymmetricDifferenceUpdate keeps in the set the items that are either in the set or in other but not in both.
+func (h *HashSet[E]) SymmetricDifferenceUpdate(other Set[E]) {
+	changed := false
+	for it := other.Iterator(); it.HasNext(); {
+		item := it.Next()
+		if h.inner[item] {
+			delete(h.inner, item)
+		} else {
+			h.inner[item] = true
+		}
+		changed = true
+	}
+	if changed {
+		h.mods++
+	}
+}
+
 func (h *HashSet[E]) String() string {
 	var sb strings.Builder
 	sb.WriteString("[")
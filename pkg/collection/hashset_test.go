@@ -112,3 +112,72 @@ func TestHashSet_Delete(t *testing.T) {
 		}
 	}
 }
+
+func TestHashSet_Update(t *testing.T) {
+	useCases := []struct {
+		description string
+		update      func(h *HashSet[int], other Set[int])
+		original    *HashSet[int]
+		other       Set[int]
+		want        *HashSet[int]
+	}{
+		{description: "union update",
+			update:   (*HashSet[int]).UnionUpdate,
+			original: NewHashSet(1, 2), other: NewHashSet(2, 3),
+			want: NewHashSet(1, 2, 3)},
+		{description: "intersection update with bigger set",
+			update:   (*HashSet[int]).IntersectionUpdate,
+			original: NewHashSet(1, 2), other: NewHashSet(2, 3, 4),
+			want: NewHashSet(2)},
+		{description: "intersection update with smaller set",
+			update:   (*HashSet[int]).IntersectionUpdate,
+			original: NewHashSet(1, 2, 3, 4), other: NewHashSet(2, 5),
+			want: NewHashSet(2)},
+		{description: "difference update with bigger set",
+			update:   (*HashSet[int]).DifferenceUpdate,
+			original: NewHashSet(1, 2), other: NewHashSet(2, 3, 4),
+			want: NewHashSet(1)},
+		{description: "difference update with smaller set",
+			update:   (*HashSet[int]).DifferenceUpdate,
+			original: NewHashSet(1, 2, 3), other: NewHashSet(3),
+			want: NewHashSet(1, 2)},
+		{description: "symmetric difference update",
+			update:   (*HashSet[int]).SymmetricDifferenceUpdate,
+			original: NewHashSet(1, 2, 3), other: NewTreeSet(3, 4),
+			want: NewHashSet(1, 2, 4)},
+	}
+
+	for _, tt := range useCases {
+		tt.update(tt.original, tt.other)
+		if !Equal[int](tt.original, tt.want) {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.want, tt.original)
+		}
+	}
+}
+
+func TestHashSet_UpdateUnchanged(t *testing.T) {
+	useCases := []struct {
+		description string
+		update      func(h *HashSet[int])
+		changed     bool
+	}{
+		{description: "union with a subset", update: func(h *HashSet[int]) { h.UnionUpdate(NewHashSet(1, 2)) }},
+		{description: "intersection with a superset", update: func(h *HashSet[int]) { h.IntersectionUpdate(NewHashSet(1, 2, 3, 4)) }},
+		{description: "intersection with a smaller superset", update: func(h *HashSet[int]) { h.IntersectionUpdate(NewTreeSet(1, 2, 3)) }},
+		{description: "difference with a disjoint set", update: func(h *HashSet[int]) { h.DifferenceUpdate(NewHashSet(7)) }},
+		{description: "symmetric difference with an empty set", update: func(h *HashSet[int]) { h.SymmetricDifferenceUpdate(NewHashSet[int]()) }},
+		{description: "union adding an item", update: func(h *HashSet[int]) { h.UnionUpdate(NewHashSet(4)) }, changed: true},
+		{description: "difference removing an item", update: func(h *HashSet[int]) { h.DifferenceUpdate(NewHashSet(1, 7)) }, changed: true},
+	}
+
+	for _, tt := range useCases {
+		set := NewHashSet(1, 2, 3)
+		it := set.Iterator()
+		it.Next()
+		tt.update(set)
+		err := modificationPanic(func() { it.Next() })
+		if changed := err != nil; changed != tt.changed {
+			t.Errorf("test: %s iterator invalidated want %v got %v", tt.description, tt.changed, changed)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package collection
+
+import (
+	"cmp"
+	"fmt"
+)
+
+var (
+	ErrInvalidHandle = fmt.Errorf("handle does not belong to the queue")
+)
+
+// Handle references an item pushed in an IndexedPriorityQueue.
+type Handle[E any, P any] struct {
+	item     E
+	priority P
+	index    int
+	queue    *IndexedPriorityQueue[E, P]
+}
+
+func (h *Handle[E, P]) Item() E {
+	return h.item
+}
+
+func (h *Handle[E, P]) Priority() P {
+	return h.priority
+}
+
+// IndexedPriorityQueue is a binary heap of items ordered by a separate
+// priority, the handle returned by Push allows to change the priority of an
+// item or to remove it in O(log n).
+type IndexedPriorityQueue[E any, P any] struct {
+	handles []*Handle[E, P]
+	compare Comparator[P]
+}
+
+// NewIndexedPriorityQueue creates an IndexedPriorityQueue where the lowest priority is popped first.
+func NewIndexedPriorityQueue[E any, P cmp.Ordered]() *IndexedPriorityQueue[E, P] {
+	return NewIndexedPriorityQueueWithComparator[E](NaturalOrder[P]())
+}
+
+// NewIndexedPriorityQueueWithComparator creates an IndexedPriorityQueue where priorities are ordered by compare.
+func NewIndexedPriorityQueueWithComparator[E any, P any](compare Comparator[P]) *IndexedPriorityQueue[E, P] {
+	return &IndexedPriorityQueue[E, P]{compare: compare}
+}
+
+func (q *IndexedPriorityQueue[E, P]) less(i, j int) bool {
+	return q.compare(q.handles[i].priority, q.handles[j].priority) < 0
+}
+
+func (q *IndexedPriorityQueue[E, P]) swap(i, j int) {
+	q.handles[i], q.handles[j] = q.handles[j], q.handles[i]
+	q.handles[i].index = i
+	q.handles[j].index = j
+}
+
+func (q *IndexedPriorityQueue[E, P]) Empty() bool {
+	return q.Size() == 0
+}
+
+func (q *IndexedPriorityQueue[E, P]) Size() int {
+	return len(q.handles)
+}
+
+// Push adds an item with the given priority and returns its handle.
+func (q *IndexedPriorityQueue[E, P]) Push(item E, priority P) *Handle[E, P] {
+	h := &Handle[E, P]{item: item, priority: priority, index: len(q.handles), queue: q}
+	q.handles = append(q.handles, h)
+	heapUp(h.index, q.less, q.swap)
+	return h
+}
+
+// Peek get the item with the lowest priority, but
+// if queue is empty well this method return ErrEmptyCollection error.
+func (q *IndexedPriorityQueue[E, P]) Peek() (E, P, error) {
+	if q.Empty() {
+		return *new(E), *new(P), ErrEmptyCollection
+	}
+	h := q.handles[0]
+	return h.item, h.priority, nil
+}
+
+// Pop get and remove the item with the lowest priority, but
+// if queue is empty well this method return ErrEmptyCollection error.
+func (q *IndexedPriorityQueue[E, P]) Pop() (E, P, error) {
+	if q.Empty() {
+		return *new(E), *new(P), ErrEmptyCollection
+	}
+	h := q.removeAt(0)
+	return h.item, h.priority, nil
+}
+
+// Contains reports whether the item referenced by h is still in the queue.
+func (q *IndexedPriorityQueue[E, P]) Contains(h *Handle[E, P]) bool {
+	return h != nil && h.queue == q && h.index >= 0
+}
+
+// Update changes the priority of the item referenced by h, but
+// if h is not in the queue well this method return ErrInvalidHandle error.
+func (q *IndexedPriorityQueue[E, P]) Update(h *Handle[E, P], priority P) error {
+	if !q.Contains(h) {
+		return ErrInvalidHandle
+	}
+	h.priority = priority
+	if !heapDown(h.index, len(q.handles), q.less, q.swap) {
+		heapUp(h.index, q.less, q.swap)
+	}
+	return nil
+}
+
+// Remove removes the item referenced by h, but
+// if h is not in the queue well this method return ErrInvalidHandle error.
+func (q *IndexedPriorityQueue[E, P]) Remove(h *Handle[E, P]) error {
+	if !q.Contains(h) {
+		return ErrInvalidHandle
+	}
+	q.removeAt(h.index)
+	return nil
+}
+
+func (q *IndexedPriorityQueue[E, P]) removeAt(i int) *Handle[E, P] {
+	n := len(q.handles) - 1
+	h := q.handles[i]
+	if i != n {
+		q.swap(i, n)
+		if !heapDown(i, n, q.less, q.swap) {
+			heapUp(i, q.less, q.swap)
+		}
+	}
+	q.handles[n] = nil
+	q.handles = q.handles[:n]
+	h.index = -1
+	return h
+}
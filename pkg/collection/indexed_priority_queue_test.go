@@ -0,0 +1,84 @@
+package collection
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIndexedPriorityQueue_Pop(t *testing.T) {
+	q := NewIndexedPriorityQueue[string, int]()
+	q.Push("c", 3)
+	q.Push("a", 1)
+	q.Push("b", 2)
+
+	var items []string
+	var priorities []int
+	for !q.Empty() {
+		item, priority, err := q.Pop()
+		if err != nil {
+			t.Fatalf("test: pop want %v got %v", nil, err)
+		}
+		items = append(items, item)
+		priorities = append(priorities, priority)
+	}
+	if !reflect.DeepEqual(items, []string{"a", "b", "c"}) || !reflect.DeepEqual(priorities, []int{1, 2, 3}) {
+		t.Errorf("test: pop in priority order want %v got %v", []string{"a", "b", "c"}, items)
+	}
+	if _, _, err := q.Pop(); err != ErrEmptyCollection {
+		t.Errorf("test: pop on empty queue want %v got %v", ErrEmptyCollection, err)
+	}
+	if _, _, err := q.Peek(); err != ErrEmptyCollection {
+		t.Errorf("test: peek on empty queue want %v got %v", ErrEmptyCollection, err)
+	}
+}
+
+func TestIndexedPriorityQueue_Update(t *testing.T) {
+	useCases := []struct {
+		description string
+		item        string
+		priority    int
+		want        string
+	}{
+		{description: "decrease priority moves item to the top", item: "c", priority: 0, want: "c"},
+		{description: "increase priority moves item down", item: "a", priority: 10, want: "b"},
+		{description: "same priority keeps the top", item: "a", priority: 1, want: "a"},
+	}
+
+	for _, tt := range useCases {
+		q := NewIndexedPriorityQueue[string, int]()
+		handles := map[string]*Handle[string, int]{
+			"a": q.Push("a", 1),
+			"b": q.Push("b", 2),
+			"c": q.Push("c", 3),
+		}
+		err := q.Update(handles[tt.item], tt.priority)
+		top, _, _ := q.Peek()
+		if top != tt.want || err != nil || handles[tt.item].Priority() != tt.priority {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.want, top)
+		}
+	}
+}
+
+func TestIndexedPriorityQueue_Remove(t *testing.T) {
+	q := NewIndexedPriorityQueue[string, int]()
+	a := q.Push("a", 1)
+	b := q.Push("b", 2)
+	q.Push("c", 3)
+
+	if err := q.Remove(a); err != nil || q.Contains(a) || q.Size() != 2 {
+		t.Errorf("test: remove top want %v got %v", nil, err)
+	}
+	if err := q.Remove(a); err != ErrInvalidHandle {
+		t.Errorf("test: remove twice want %v got %v", ErrInvalidHandle, err)
+	}
+	if err := q.Update(a, 0); err != ErrInvalidHandle {
+		t.Errorf("test: update removed handle want %v got %v", ErrInvalidHandle, err)
+	}
+	other := NewIndexedPriorityQueue[string, int]()
+	if err := other.Remove(b); err != ErrInvalidHandle {
+		t.Errorf("test: remove handle of another queue want %v got %v", ErrInvalidHandle, err)
+	}
+	if item, _, _ := q.Pop(); item != b.Item() {
+		t.Errorf("test: pop after remove want %v got %v", b.Item(), item)
+	}
+}
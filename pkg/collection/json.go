@@ -0,0 +1,566 @@
+package collection
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"maps"
+	"reflect"
+	"slices"
+)
+
+var (
+	ErrMissingComparator = fmt.Errorf("collection has no comparator, create it with its constructor")
+)
+
+// Lists, queues, stacks and sets are encoded as JSON arrays in iteration
+// order. Maps are encoded as JSON objects when their keys are strings or
+// implement both encoding.TextMarshaler and encoding.TextUnmarshaler,
+// otherwise as arrays of [key, value] pairs.
+// Unmarshalling replaces the content of the collection.
+
+// marshalItems encodes the items of seq as a JSON array, never as null.
+func marshalItems[E any](seq iter.Seq[E]) ([]byte, error) {
+	items := make([]E, 0)
+	for item := range seq {
+		items = append(items, item)
+	}
+	return json.Marshal(items)
+}
+
+func unmarshalItems[E any](data []byte) ([]E, error) {
+	var items []E
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+var (
+	textMarshalerType   = reflect.TypeFor[encoding.TextMarshaler]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+// textKeys reports whether the keys of type K are encoded as the names of a
+// JSON object, both marshalMap and unmarshalMap rely on it so that a map
+// always reads back what it wrote.
+func textKeys[K any]() bool {
+	return reflect.TypeFor[K]().Kind() == reflect.String || textMethods[K]()
+}
+
+// textMethods reports whether K converts to and from text, a type with only
+// one of the two methods is encoded like any other value.
+func textMethods[K any]() bool {
+	t := reflect.TypeFor[K]()
+	return t.Implements(textMarshalerType) && reflect.PointerTo(t).Implements(textUnmarshalerType)
+}
+
+func marshalKey[K any](key K) ([]byte, error) {
+	if textMethods[K]() {
+		text, err := any(key).(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(string(text))
+	}
+	if v := reflect.ValueOf(&key).Elem(); v.Kind() == reflect.String {
+		return json.Marshal(v.String())
+	}
+	return json.Marshal(key)
+}
+
+func unmarshalKey[K any](name string) (K, error) {
+	var key K
+	if textMethods[K]() {
+		return key, any(&key).(encoding.TextUnmarshaler).UnmarshalText([]byte(name))
+	}
+	if v := reflect.ValueOf(&key).Elem(); v.Kind() == reflect.String {
+		v.SetString(name)
+		return key, nil
+	}
+	return key, fmt.Errorf("map key type %T can not be decoded from text", key)
+}
+
+// marshalMap encodes the pairs of seq in their order, or sorted by encoded
+// key when sorted is true so that unordered maps give a stable output.
+func marshalMap[K any, V any](seq iter.Seq2[K, V], sorted bool) ([]byte, error) {
+	type field struct {
+		key   []byte
+		value []byte
+	}
+	fields := make([]field, 0)
+	for k, v := range seq {
+		key, err := marshalKey(k)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, field{key, value})
+	}
+	if sorted {
+		slices.SortFunc(fields, func(a, b field) int {
+			return bytes.Compare(a.key, b.key)
+		})
+	}
+
+	object := textKeys[K]()
+	var buf bytes.Buffer
+	if object {
+		buf.WriteByte('{')
+	} else {
+		buf.WriteByte('[')
+	}
+	for i, f := range fields {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if object {
+			buf.Write(f.key)
+			buf.WriteByte(':')
+			buf.Write(f.value)
+		} else {
+			buf.WriteByte('[')
+			buf.Write(f.key)
+			buf.WriteByte(',')
+			buf.Write(f.value)
+			buf.WriteByte(']')
+		}
+	}
+	if object {
+		buf.WriteByte('}')
+	} else {
+		buf.WriteByte(']')
+	}
+	return buf.Bytes(), nil
+}
+
+// unmarshalMap decodes the pairs written by marshalMap and gives them to put.
+func unmarshalMap[K any, V any](data []byte, put func(key K, value V)) error {
+	if textKeys[K]() {
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(data, &object); err != nil {
+			return err
+		}
+		for name, raw := range object {
+			key, err := unmarshalKey[K](name)
+			if err != nil {
+				return err
+			}
+			var value V
+			if err := json.Unmarshal(raw, &value); err != nil {
+				return err
+			}
+			put(key, value)
+		}
+		return nil
+	}
+
+	var pairs [][2]json.RawMessage
+	if err := json.Unmarshal(data, &pairs); err != nil {
+		return err
+	}
+	for _, pair := range pairs {
+		var key K
+		if err := json.Unmarshal(pair[0], &key); err != nil {
+			return err
+		}
+		var value V
+		if err := json.Unmarshal(pair[1], &value); err != nil {
+			return err
+		}
+		put(key, value)
+	}
+	return nil
+}
+
+func (s *Slice[E]) MarshalJSON() ([]byte, error) {
+	return marshalItems(slices.Values(s.inner))
+}
+
+func (s *Slice[E]) UnmarshalJSON(data []byte) error {
+	items, err := unmarshalItems[E](data)
+	if err != nil {
+		return err
+	}
+	return s.load(items)
+}
+
+func (l *LinkedList[E]) MarshalJSON() ([]byte, error) {
+	return marshalItems(l.All())
+}
+
+func (l *LinkedList[E]) UnmarshalJSON(data []byte) error {
+	items, err := unmarshalItems[E](data)
+	if err != nil {
+		return err
+	}
+	return l.load(items)
+}
+
+func (s *Stack[E]) MarshalJSON() ([]byte, error) {
+	return marshalItems(s.All())
+}
+
+func (s *Stack[E]) UnmarshalJSON(data []byte) error {
+	items, err := unmarshalItems[E](data)
+	if err != nil {
+		return err
+	}
+	return s.load(items)
+}
+
+func (q *Queue[E]) MarshalJSON() ([]byte, error) {
+	return marshalItems(q.All())
+}
+
+func (q *Queue[E]) UnmarshalJSON(data []byte) error {
+	items, err := unmarshalItems[E](data)
+	if err != nil {
+		return err
+	}
+	return q.load(items)
+}
+
+func (d *Deque[E]) MarshalJSON() ([]byte, error) {
+	return marshalItems(d.All())
+}
+
+func (d *Deque[E]) UnmarshalJSON(data []byte) error {
+	items, err := unmarshalItems[E](data)
+	if err != nil {
+		return err
+	}
+	return d.load(items)
+}
+
+// MarshalJSON encodes the items of the queue in the order they would be popped.
+func (q *PriorityQueue[E]) MarshalJSON() ([]byte, error) {
+	return marshalItems(slices.Values(q.sorted()))
+}
+
+// UnmarshalJSON replaces the items of the queue, but if the queue has not
+// been created by its constructor well this method return ErrMissingComparator error.
+func (q *PriorityQueue[E]) UnmarshalJSON(data []byte) error {
+	if q.compare == nil {
+		return ErrMissingComparator
+	}
+	items, err := unmarshalItems[E](data)
+	if err != nil {
+		return err
+	}
+	return q.load(items)
+}
+
+// MarshalJSON encodes the items of the set in random order, use SortedJSON
+// for a stable output.
+func (h *HashSet[E]) MarshalJSON() ([]byte, error) {
+	return marshalItems(h.All())
+}
+
+func (h *HashSet[E]) UnmarshalJSON(data []byte) error {
+	items, err := unmarshalItems[E](data)
+	if err != nil {
+		return err
+	}
+	return h.load(items)
+}
+
+// MarshalJSON encodes the items of the set in ascending order.
+func (s *TreeSet[E]) MarshalJSON() ([]byte, error) {
+	return marshalItems(s.All())
+}
+
+// UnmarshalJSON replaces the items of the set, items out of the range of a
+// view are ignored. If the set has not been created by its constructor
+// well this method return ErrMissingComparator error.
+func (s *TreeSet[E]) UnmarshalJSON(data []byte) error {
+	if s.tree == nil {
+		return ErrMissingComparator
+	}
+	items, err := unmarshalItems[E](data)
+	if err != nil {
+		return err
+	}
+	return s.load(items)
+}
+
+// The load methods replace the content of a collection with items, they are
+// shared by the JSON and gob decoders.
+
+func (s *Slice[E]) load(items []E) error {
+	s.inner = items
+	s.mods++
+	return nil
+}
+
+func (l *LinkedList[E]) load(items []E) error {
+	l.head, l.tail, l.size = nil, nil, 0
+	l.mods++
+	for _, item := range items {
+		l.PushBack(item)
+	}
+	return nil
+}
+
+func (s *Stack[E]) load(items []E) error {
+	s.items = items
+	return nil
+}
+
+func (q *Queue[E]) load(items []E) error {
+	q.Clear()
+	for _, item := range items {
+		q.Enqueue(item)
+	}
+	return nil
+}
+
+func (d *Deque[E]) load(items []E) error {
+	d.blocks, d.head, d.size = nil, 0, 0
+	d.mods++
+	for _, item := range items {
+		d.PushBack(item)
+	}
+	return nil
+}
+
+func (q *PriorityQueue[E]) load(items []E) error {
+	if q.compare == nil {
+		return ErrMissingComparator
+	}
+	q.items = items
+	for i := len(q.items)/2 - 1; i >= 0; i-- {
+		heapDown(i, len(q.items), q.less, q.swap)
+	}
+	q.mods++
+	return nil
+}
+
+// sorted returns the items of the queue in the order they would be popped.
+func (q *PriorityQueue[E]) sorted() []E {
+	items := slices.Clone(q.items)
+	slices.SortFunc(items, q.compare)
+	return items
+}
+
+func (h *HashSet[E]) load(items []E) error {
+	h.inner = make(map[E]bool, len(items))
+	for _, item := range items {
+		h.inner[item] = true
+	}
+	h.mods++
+	return nil
+}
+
+func (s *TreeSet[E]) load(items []E) error {
+	if s.tree == nil {
+		return ErrMissingComparator
+	}
+	for it := s.Iterator().(MutableIterator[E]); it.HasNext(); {
+		it.Next()
+		it.Remove()
+	}
+	for _, item := range items {
+		s.Push(item)
+	}
+	return nil
+}
+
+// load replaces the items of the queue and wakes up the consumers, a
+// bounded queue may end up holding more items than its capacity, producers
+// then wait until enough items are taken. A zero queue becomes unbounded.
+func (q *BlockingQueue[E]) load(items []E) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.items == nil {
+		q.items = NewQueue[E]()
+		q.notEmpty = make(chan struct{})
+		q.notFull = make(chan struct{})
+	}
+	q.items.load(items)
+	q.notEmpty = q.signal(q.notEmpty)
+	return nil
+}
+
+// snapshot returns the items of the queue in FIFO order.
+func (q *BlockingQueue[E]) snapshot() []E {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return slices.AppendSeq(make([]E, 0, q.items.Size()), q.items.All())
+}
+
+// lockAll locks every shard in order, so that the map can be read or
+// replaced as a whole. It returns the function unlocking them.
+func (m *ConcurrentHashMap[K, V]) lockAll(write bool) func() {
+	for _, s := range m.shards {
+		if write {
+			s.mu.Lock()
+		} else {
+			s.mu.RLock()
+		}
+	}
+	return func() {
+		for _, s := range m.shards {
+			if write {
+				s.mu.Unlock()
+			} else {
+				s.mu.RUnlock()
+			}
+		}
+	}
+}
+
+// snapshot copies the pairs of the map with every shard locked, unlike All
+// it gives a state the map really was in.
+func (m *ConcurrentHashMap[K, V]) snapshot() map[K]V {
+	unlock := m.lockAll(false)
+	defer unlock()
+	table := make(map[K]V, m.Size())
+	for _, s := range m.shards {
+		for k, v := range s.table {
+			table[k] = v
+		}
+	}
+	return table
+}
+
+// load replaces the pairs of the map with every shard locked, a zero map
+// gets the default number of shards.
+func (m *ConcurrentHashMap[K, V]) load(table map[K]V) error {
+	if m.shards == nil {
+		empty := NewConcurrentHashMap[K, V]()
+		m.shards, m.seed = empty.shards, empty.seed
+	}
+	unlock := m.lockAll(true)
+	defer unlock()
+	for _, s := range m.shards {
+		clear(s.table)
+	}
+	for k, v := range table {
+		m.shard(k).table[k] = v
+	}
+	m.size.Store(int64(len(table)))
+	return nil
+}
+
+type sortedJSON[E any] struct {
+	set     Collection[E]
+	compare Comparator[E]
+}
+
+// SortedJSON wraps set so that its items are encoded in the order given by
+// compare, which makes the output of unordered sets stable.
+func SortedJSON[E any](set Collection[E], compare Comparator[E]) json.Marshaler {
+	return sortedJSON[E]{set: set, compare: compare}
+}
+
+func (s sortedJSON[E]) MarshalJSON() ([]byte, error) {
+	items := make([]E, 0, s.set.Size())
+	for it := s.set.Iterator(); it.HasNext(); {
+		items = append(items, it.Next())
+	}
+	slices.SortFunc(items, s.compare)
+	return json.Marshal(items)
+}
+
+// MarshalJSON encodes the map sorted by key so that the output is stable.
+func (h *HashMap[K, V]) MarshalJSON() ([]byte, error) {
+	return marshalMap(h.All(), true)
+}
+
+func (h *HashMap[K, V]) UnmarshalJSON(data []byte) error {
+	table := make(map[K]V)
+	err := unmarshalMap(data, func(key K, value V) {
+		table[key] = value
+	})
+	if err != nil {
+		return err
+	}
+	h.table = table
+	return nil
+}
+
+// MarshalJSON encodes the map in ascending order of its keys.
+func (t *TreeMap[K, V]) MarshalJSON() ([]byte, error) {
+	return marshalMap(t.All(), false)
+}
+
+// UnmarshalJSON replaces the entries of the map, keys out of the range of a
+// view are ignored. If the map has not been created by its constructor
+// well this method return ErrMissingComparator error.
+func (t *TreeMap[K, V]) UnmarshalJSON(data []byte) error {
+	if t.tree == nil {
+		return ErrMissingComparator
+	}
+	var entries []*Entry[K, V]
+	err := unmarshalMap(data, func(key K, value V) {
+		entries = append(entries, NewEntry(key, value))
+	})
+	if err != nil {
+		return err
+	}
+	t.load(entries)
+	return nil
+}
+
+// load replaces the entries of the map, on views only the entries in range.
+func (t *TreeMap[K, V]) load(entries []*Entry[K, V]) {
+	t.Clear()
+	for _, e := range entries {
+		t.Put(e.key, e.value)
+	}
+}
+
+// MarshalJSON encodes a snapshot of the queue in FIFO order.
+func (q *BlockingQueue[E]) MarshalJSON() ([]byte, error) {
+	return marshalItems(slices.Values(q.snapshot()))
+}
+
+func (q *BlockingQueue[E]) UnmarshalJSON(data []byte) error {
+	items, err := unmarshalItems[E](data)
+	if err != nil {
+		return err
+	}
+	return q.load(items)
+}
+
+// MarshalJSON encodes a snapshot of the map taken with every shard locked,
+// sorted by key so that the output is stable.
+func (m *ConcurrentHashMap[K, V]) MarshalJSON() ([]byte, error) {
+	return marshalMap(maps.All(m.snapshot()), true)
+}
+
+func (m *ConcurrentHashMap[K, V]) UnmarshalJSON(data []byte) error {
+	table := make(map[K]V)
+	err := unmarshalMap(data, func(key K, value V) {
+		table[key] = value
+	})
+	if err != nil {
+		return err
+	}
+	return m.load(table)
+}
+
+type entryFields[K comparable, V any] struct {
+	Key   K `json:"key"`
+	Value V `json:"value"`
+}
+
+// MarshalJSON encodes the entry as an object with a key and a value field.
+func (e Entry[K, V]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(entryFields[K, V]{e.key, e.value})
+}
+
+func (e *Entry[K, V]) UnmarshalJSON(data []byte) error {
+	var entry entryFields[K, V]
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return err
+	}
+	e.key, e.value = entry.Key, entry.Value
+	return nil
+}
@@ -0,0 +1,258 @@
+package collection
+
+import (
+	"context"
+	"encoding/json"
+	"net/netip"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	useCases := []struct {
+		description string
+		value       any
+		want        string
+	}{
+		{description: "slice", value: NewSlice(1, 2, 3), want: `[1,2,3]`},
+		{description: "empty slice", value: NewSlice[int](), want: `[]`},
+		{description: "linked list", value: NewLinkedList("a", "b"), want: `["a","b"]`},
+		{description: "empty linked list", value: NewLinkedList[string](), want: `[]`},
+		{description: "stack from bottom to top", value: NewStack(1, 2, 3), want: `[1,2,3]`},
+		{description: "queue in fifo order", value: dequeued(NewQueue(1, 2, 3, 4)), want: `[2,3,4]`},
+		{description: "deque", value: frontPushed(NewDeque(2, 3)), want: `[1,2,3]`},
+		{description: "priority queue in pop order", value: NewPriorityQueue(3, 1, 4, 1, 5), want: `[1,1,3,4,5]`},
+		{description: "hash set with one item", value: NewHashSet(1), want: `[1]`},
+		{description: "sorted hash set", value: SortedJSON[int](NewHashSet(3, 1, 2), NaturalOrder[int]()), want: `[1,2,3]`},
+		{description: "tree set", value: NewTreeSetWithComparator(ReverseOrder(NaturalOrder[int]()), 1, 3, 2), want: `[3,2,1]`},
+		{description: "hash map with string keys", value: NewHashMap(NewEntry("b", 2), NewEntry("a", 1)), want: `{"a":1,"b":2}`},
+		{description: "hash map with int keys", value: NewHashMap(NewEntry(2, "b"), NewEntry(1, "a")), want: `[[1,"a"],[2,"b"]]`},
+		{description: "hash map with text keys", value: NewHashMap(NewEntry(netip.MustParseAddr("10.0.0.1"), true)), want: `{"10.0.0.1":true}`},
+		{description: "empty hash map", value: NewHashMap[string, int](), want: `{}`},
+		{description: "tree map in key order", value: NewTreeMapWithComparator(ReverseOrder(NaturalOrder[string]()), NewEntry("a", 1), NewEntry("b", 2)), want: `{"b":2,"a":1}`},
+		{description: "tree map with int keys", value: NewTreeMap(NewEntry(2, "b"), NewEntry(1, "a")), want: `[[1,"a"],[2,"b"]]`},
+		{description: "entry", value: NewEntry("a", 1), want: `{"key":"a","value":1}`},
+		{description: "blocking queue in fifo order", value: fullBlockingQueue(3), want: `[0,1,2]`},
+		{description: "concurrent hash map sorted by key", value: NewConcurrentHashMap(NewEntry("b", 2), NewEntry("a", 1)), want: `{"a":1,"b":2}`},
+		{description: "nested collections", value: NewSlice(NewLinkedList(1), NewLinkedList(2, 3)), want: `[[1],[2,3]]`},
+	}
+
+	for _, tt := range useCases {
+		data, err := json.Marshal(tt.value)
+		if err != nil || string(data) != tt.want {
+			t.Errorf("test: %s want %v got %s (%v)", tt.description, tt.want, data, err)
+		}
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	useCases := []struct {
+		description string
+		data        string
+		value       any
+		want        any
+	}{
+		{description: "slice", data: `[1,2,3]`, value: NewSlice(9), want: []int{1, 2, 3}},
+		{description: "zero slice", data: `[1]`, value: &Slice[int]{}, want: []int{1}},
+		{description: "linked list", data: `["a","b"]`, value: NewLinkedList("z"), want: []string{"a", "b"}},
+		{description: "zero linked list", data: `["a"]`, value: &LinkedList[string]{}, want: []string{"a"}},
+		{description: "stack", data: `[1,2,3]`, value: NewStack[int](), want: []int{1, 2, 3}},
+		{description: "queue", data: `[1,2,3]`, value: NewQueue(9, 9), want: []int{1, 2, 3}},
+		{description: "zero queue", data: `[1,2]`, value: &Queue[int]{}, want: []int{1, 2}},
+		{description: "deque", data: `[1,2,3]`, value: NewDeque(9), want: []int{1, 2, 3}},
+		{description: "priority queue", data: `[5,1,3]`, value: NewPriorityQueue(9), want: []int{1, 3, 5}},
+		{description: "hash set", data: `[1,2,2]`, value: NewHashSet(9), want: []int{1, 2}},
+		{description: "zero hash set", data: `[1]`, value: &HashSet[int]{}, want: []int{1}},
+		{description: "tree set", data: `[3,1,2]`, value: NewTreeSet(9), want: []int{1, 2, 3}},
+		{description: "tree set view", data: `[3,1,2]`, value: NewTreeSet(0, 9).HeadSet(3, false), want: []int{1, 2}},
+		{description: "hash map with string keys", data: `{"a":1,"b":2}`, value: NewHashMap(NewEntry("z", 0)), want: map[string]int{"a": 1, "b": 2}},
+		{description: "zero hash map", data: `{"a":1}`, value: &HashMap[string, int]{}, want: map[string]int{"a": 1}},
+		{description: "hash map with int keys", data: `[[1,"a"],[2,"b"]]`, value: NewHashMap[int, string](), want: map[int]string{1: "a", 2: "b"}},
+		{description: "hash map with text keys", data: `{"10.0.0.1":true}`, value: NewHashMap[netip.Addr, bool](), want: map[netip.Addr]bool{netip.MustParseAddr("10.0.0.1"): true}},
+		{description: "tree map", data: `{"b":2,"a":1}`, value: NewTreeMap(NewEntry("z", 0)), want: map[string]int{"a": 1, "b": 2}},
+		{description: "tree map view", data: `{"b":2,"a":1,"y":3}`, value: NewTreeMap(NewEntry("a", 0), NewEntry("z", 0)).HeadMap("b"), want: map[string]int{"a": 1}},
+		{description: "entry", data: `{"key":"a","value":1}`, value: &Entry[string, int]{}, want: map[string]int{"a": 1}},
+		{description: "blocking queue", data: `[1,2,3]`, value: fullBlockingQueue(2), want: []int{1, 2, 3}},
+		{description: "zero blocking queue", data: `[1]`, value: &BlockingQueue[int]{}, want: []int{1}},
+		{description: "concurrent hash map", data: `{"a":1,"b":2}`, value: NewConcurrentHashMap(NewEntry("z", 0)), want: map[string]int{"a": 1, "b": 2}},
+		{description: "zero concurrent hash map", data: `{"a":1}`, value: &ConcurrentHashMap[string, int]{}, want: map[string]int{"a": 1}},
+	}
+
+	for _, tt := range useCases {
+		if err := json.Unmarshal([]byte(tt.data), tt.value); err != nil {
+			t.Errorf("test: %s want %v got %v", tt.description, nil, err)
+			continue
+		}
+		got := jsonContent(tt.value)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.want, got)
+		}
+	}
+}
+
+func TestUnmarshalJSON_Errors(t *testing.T) {
+	useCases := []struct {
+		description string
+		data        string
+		value       any
+		err         error
+	}{
+		{description: "zero priority queue", data: `[1]`, value: &PriorityQueue[int]{}, err: ErrMissingComparator},
+		{description: "zero tree set", data: `[1]`, value: &TreeSet[int]{}, err: ErrMissingComparator},
+		{description: "zero tree map", data: `{}`, value: &TreeMap[string, int]{}, err: ErrMissingComparator},
+	}
+
+	for _, tt := range useCases {
+		err := json.Unmarshal([]byte(tt.data), tt.value)
+		if err != tt.err {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.err, err)
+		}
+	}
+
+	invalid := []struct {
+		description string
+		data        string
+		value       any
+	}{
+		{description: "object into slice", data: `{"a":1}`, value: NewSlice[int]()},
+		{description: "wrong item type", data: `["a"]`, value: NewLinkedList[int]()},
+		{description: "array into string keyed map", data: `[["a",1]]`, value: NewHashMap[string, int]()},
+		{description: "object into int keyed map", data: `{"1":"a"}`, value: NewHashMap[int, string]()},
+		{description: "invalid text key", data: `{"not an ip":true}`, value: NewHashMap[netip.Addr, bool]()},
+	}
+
+	for _, tt := range invalid {
+		if err := json.Unmarshal([]byte(tt.data), tt.value); err == nil {
+			t.Errorf("test: %s want an error got %v", tt.description, err)
+		}
+	}
+}
+
+func dequeued(q *Queue[int]) *Queue[int] {
+	q.Dequeue()
+	return q
+}
+
+func frontPushed(d *Deque[int]) *Deque[int] {
+	d.PushFront(1)
+	return d
+}
+
+// jsonContent returns the items of an unmarshalled value as a plain slice or map.
+func jsonContent(value any) any {
+	switch v := value.(type) {
+	case *Slice[int]:
+		return Collect(v.All()).inner
+	case *LinkedList[string]:
+		return Collect(v.All()).inner
+	case *Stack[int]:
+		return Collect(v.All()).inner
+	case *Queue[int]:
+		return Collect(v.All()).inner
+	case *Deque[int]:
+		return Collect(v.All()).inner
+	case *PriorityQueue[int]:
+		var items []int
+		for !v.Empty() {
+			item, _ := v.Pop()
+			items = append(items, item)
+		}
+		return items
+	case *HashSet[int]:
+		return slices.Sorted(v.All())
+	case *TreeSet[int]:
+		return Collect(v.All()).inner
+	case *HashMap[string, int]:
+		return v.table
+	case *HashMap[int, string]:
+		return v.table
+	case *HashMap[netip.Addr, bool]:
+		return v.table
+	case *TreeMap[string, int]:
+		items := map[string]int{}
+		for k, val := range v.All() {
+			items[k] = val
+		}
+		return items
+	case *Entry[string, int]:
+		return map[string]int{v.Key(): v.Value()}
+	case *BlockingQueue[int]:
+		return v.ToSlice()
+	case *ConcurrentHashMap[string, int]:
+		return v.snapshot()
+	}
+	return nil
+}
+
+func TestUnmarshalJSON_Concurrent(t *testing.T) {
+	m := &ConcurrentHashMap[string, int]{}
+	if err := json.Unmarshal([]byte(`{"a":1,"b":2}`), m); err != nil || m.Size() != 2 {
+		t.Errorf("test: zero concurrent hash map size want %v got %v (%v)", 2, m.Size(), err)
+	}
+	m.Put("c", 3)
+	if v, ok := m.Get("c"); !ok || v != 3 || m.Size() != 3 {
+		t.Errorf("test: put after unmarshal want %v got %v", 3, v)
+	}
+
+	q := NewBlockingQueue[int](0)
+	taken := make(chan int)
+	go func() {
+		item, _ := q.Take(context.Background())
+		taken <- item
+	}()
+	time.Sleep(10 * time.Millisecond)
+	if err := json.Unmarshal([]byte(`[7]`), q); err != nil {
+		t.Errorf("test: blocking queue want %v got %v", nil, err)
+	}
+	if item := <-taken; item != 7 {
+		t.Errorf("test: waiting consumer want %v got %v", 7, item)
+	}
+}
+
+// textOutKey converts to text but reads JSON, it is not a text key.
+type textOutKey struct{ name string }
+
+func (k textOutKey) MarshalText() ([]byte, error) {
+	return []byte(k.name), nil
+}
+
+func (k *textOutKey) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &k.name)
+}
+
+// textInKey reads text but writes JSON, it is not a text key.
+type textInKey struct{ name string }
+
+func (k textInKey) MarshalJSON() ([]byte, error) {
+	return json.Marshal(k.name)
+}
+
+func (k *textInKey) UnmarshalText(text []byte) error {
+	k.name = string(text)
+	return nil
+}
+
+func TestMarshalJSON_OneSidedTextKeys(t *testing.T) {
+	out := NewHashMap(NewEntry(textOutKey{"a"}, 1))
+	data, err := json.Marshal(out)
+	if err != nil || string(data) != `[["a",1]]` {
+		t.Errorf("test: key with only MarshalText want %v got %s (%v)", `[["a",1]]`, data, err)
+	}
+	outBack := NewHashMap[textOutKey, int]()
+	if err := json.Unmarshal(data, outBack); err != nil || outBack.table[textOutKey{"a"}] != 1 {
+		t.Errorf("test: round trip of a key with only MarshalText want %v got %v (%v)", out.table, outBack.table, err)
+	}
+
+	in := NewTreeMapWithComparator(func(a, b textInKey) int { return strings.Compare(a.name, b.name) }, NewEntry(textInKey{"b"}, 2))
+	data, err = json.Marshal(in)
+	if err != nil || string(data) != `[["b",2]]` {
+		t.Errorf("test: key with only UnmarshalText want %v got %s (%v)", `[["b",2]]`, data, err)
+	}
+	inBack := NewTreeMapWithComparator[textInKey, int](func(a, b textInKey) int { return strings.Compare(a.name, b.name) })
+	if err := json.Unmarshal(data, inBack); err != nil || inBack.Size() != 1 || !inBack.ContainsKey(textInKey{"b"}) {
+		t.Errorf("test: round trip of a key with only UnmarshalText want %v got %v (%v)", in, inBack, err)
+	}
+}
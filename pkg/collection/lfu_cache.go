@@ -0,0 +1,77 @@
+package collection
+
+// LFUCache is a cache that evicts the least frequently used entry first and,
+// among entries used as often, the least recently used one. Get and Put count
+// as a use while Peek does not. Every operation takes O(1). It is not safe
+// for concurrent use, see SynchronizedCache.
+type LFUCache[K comparable, V any] struct {
+	*cache[K, V]
+}
+
+// NewLFUCache creates a cache of at most maxEntries entries, a maxEntries
+// below 1 means the count is not bounded and only the max cost, if any, is.
+func NewLFUCache[K comparable, V any](maxEntries int, opts ...CacheOption[K, V]) *LFUCache[K, V] {
+	policy := &lfuPolicy[K]{keys: make(map[K]*node[*lfuBucket[K]]), buckets: NewLinkedList[*lfuBucket[K]]()}
+	return &LFUCache[K, V]{newCache(maxEntries, policy, opts)}
+}
+
+// lfuBucket holds the keys used freq times from the least to the most recently used.
+type lfuBucket[K comparable] struct {
+	freq int
+	keys *LinkedHashSet[K]
+}
+
+// lfuPolicy keeps the non empty buckets in ascending order of frequency, a
+// use moves the key to the next bucket, creating it when needed.
+type lfuPolicy[K comparable] struct {
+	keys    map[K]*node[*lfuBucket[K]]
+	buckets *LinkedList[*lfuBucket[K]]
+}
+
+// addTo adds key to the bucket of frequency freq, which is either n or a
+// new one linked before n, or at the back when n is nil.
+func (p *lfuPolicy[K]) addTo(key K, freq int, n *node[*lfuBucket[K]]) {
+	target := n
+	if n == nil || n.value.freq != freq {
+		target = p.buckets.linkBefore(&lfuBucket[K]{freq: freq, keys: NewLinkedHashSet[K]()}, n)
+	}
+	target.value.keys.Push(key)
+	p.keys[key] = target
+}
+
+func (p *lfuPolicy[K]) add(key K) {
+	p.addTo(key, 1, p.buckets.head)
+}
+
+func (p *lfuPolicy[K]) touch(key K) {
+	n := p.keys[key]
+	// read before detach which may unlink n
+	freq, next := n.value.freq+1, n.next
+	p.detach(key, n)
+	p.addTo(key, freq, next)
+}
+
+// detach removes key from the bucket n, dropping the bucket when it becomes empty.
+func (p *lfuPolicy[K]) detach(key K, n *node[*lfuBucket[K]]) {
+	n.value.keys.Delete(key)
+	delete(p.keys, key)
+	if n.value.keys.Empty() {
+		p.buckets.unlink(n)
+	}
+}
+
+func (p *lfuPolicy[K]) remove(key K) {
+	if n, ok := p.keys[key]; ok {
+		p.detach(key, n)
+	}
+}
+
+// victim looks at two buckets at most, keep is in only one of them.
+func (p *lfuPolicy[K]) victim(keep K) (K, bool) {
+	for n := p.buckets.head; n != nil; n = n.next {
+		if key, ok := firstExcept(n.value.keys, keep); ok {
+			return key, true
+		}
+	}
+	return *new(K), false
+}
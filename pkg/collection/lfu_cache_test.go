@@ -0,0 +1,111 @@
+package collection
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestLFUCache_Eviction(t *testing.T) {
+	useCases := []struct {
+		description string
+		ops         func(c *LFUCache[string, int])
+		evicted     []string
+	}{
+		{description: "least recently used among equals", ops: func(c *LFUCache[string, int]) {
+			c.Put("d", 4)
+		}, evicted: []string{"a"}},
+		{description: "least frequently used first", ops: func(c *LFUCache[string, int]) {
+			c.Get("a")
+			c.Get("a")
+			c.Get("b")
+			c.Put("d", 4)
+			c.Put("e", 5)
+		}, evicted: []string{"c", "d"}},
+		{description: "put counts as a use", ops: func(c *LFUCache[string, int]) {
+			c.Put("a", 10)
+			c.Put("d", 4)
+		}, evicted: []string{"b"}},
+		{description: "peek does not count", ops: func(c *LFUCache[string, int]) {
+			c.Peek("a")
+			c.Put("d", 4)
+		}, evicted: []string{"a"}},
+	}
+
+	for _, tt := range useCases {
+		var evicted []string
+		c := NewLFUCache(3, WithEvictionCallback(func(key string, _ int, _ EvictionReason) {
+			evicted = append(evicted, key)
+		}))
+		c.Put("a", 1)
+		c.Put("b", 2)
+		c.Put("c", 3)
+		tt.ops(c)
+		if !slices.Equal(evicted, tt.evicted) || c.Len() != 3 {
+			t.Errorf("test: %s want %v got %v len %d", tt.description, tt.evicted, evicted, c.Len())
+		}
+	}
+}
+
+// TestLFUCache_Random checks the victims against a model scanning every
+// entry for the lowest frequency and then the oldest use.
+func TestLFUCache_Random(t *testing.T) {
+	type use struct {
+		freq int
+		last int
+	}
+	r := rand.New(rand.NewSource(1))
+	model := make(map[int]*use)
+	var victim int
+	c := NewLFUCache(16, WithEvictionCallback(func(key int, _ int, _ EvictionReason) {
+		victim = key
+	}))
+
+	for step := range 5000 {
+		key := r.Intn(40)
+		if r.Intn(2) == 0 {
+			if _, ok := c.Get(key); ok != (model[key] != nil) {
+				t.Fatalf("test: step %d get %d want %v got %v", step, key, model[key] != nil, ok)
+			}
+			if u := model[key]; u != nil {
+				u.freq++
+				u.last = step
+			}
+			continue
+		}
+
+		want := -1
+		if model[key] == nil && len(model) == 16 {
+			var best *use
+			for k, u := range model {
+				if best == nil || u.freq < best.freq || (u.freq == best.freq && u.last < best.last) {
+					want, best = k, u
+				}
+			}
+		}
+		victim = -1
+		c.Put(key, step)
+		if victim != want {
+			t.Fatalf("test: step %d put %d want victim %d got %d", step, key, want, victim)
+		}
+		if want >= 0 {
+			delete(model, want)
+		}
+		if u := model[key]; u != nil {
+			u.freq++
+			u.last = step
+		} else {
+			model[key] = &use{freq: 1, last: step}
+		}
+	}
+}
+
+func BenchmarkLFUCache_Put(b *testing.B) {
+	c := NewLFUCache[int, int](1000)
+	i := 0
+	for b.Loop() {
+		c.Put(i, i)
+		c.Get(i / 2)
+		i++
+	}
+}
@@ -0,0 +1,193 @@
+package collection
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// LinkedHashMap is a hash map that remembers the order its keys were
+// inserted in, putting a key already in the map does not change its place.
+// Keys, Values, EntryList, All and String follow that order. MoveToFront and
+// MoveToBack reorder the keys, for example to keep them in access order.
+// Every operation takes O(1).
+type LinkedHashMap[K comparable, V any] struct {
+	table map[K]*node[*Entry[K, V]]
+	order *LinkedList[*Entry[K, V]]
+	equal EqualFunc[V]
+}
+
+func NewLinkedHashMap[K comparable, V any](entries ...*Entry[K, V]) *LinkedHashMap[K, V] {
+	m := &LinkedHashMap[K, V]{table: make(map[K]*node[*Entry[K, V]]), order: NewLinkedList[*Entry[K, V]]()}
+	for _, e := range entries {
+		m.Put(e.key, e.value)
+	}
+	return m
+}
+
+// NewLinkedHashMapWith creates an empty linked hash map configured with the given value options.
+func NewLinkedHashMapWith[K comparable, V any](opts ...Option[V]) *LinkedHashMap[K, V] {
+	m := NewLinkedHashMap[K, V]()
+	m.equal = newOptions(opts...).equal
+	return m
+}
+
+func (m *LinkedHashMap[K, V]) eq() EqualFunc[V] {
+	if m.equal == nil {
+		return DefaultEqual[V]()
+	}
+	return m.equal
+}
+
+// Iterator returns an iterator over the entries of the map in insertion
+// order, it is a MutableIterator.
+func (m *LinkedHashMap[K, V]) Iterator() Iterator[*Entry[K, V]] {
+	if m.Empty() {
+		return &emptyListIterator[*Entry[K, V]]{}
+	}
+	return &linkedHashIterator[*Entry[K, V]]{
+		it: &listIterator[*Entry[K, V]]{currentNode: m.order.head, list: m.order, expected: m.order.mods},
+		remove: func(e *Entry[K, V]) {
+			delete(m.table, e.key)
+		},
+	}
+}
+
+// All returns a sequence over the pairs of the map in insertion order.
+func (m *LinkedHashMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e := range m.order.All() {
+			if !yield(e.key, e.value) {
+				return
+			}
+		}
+	}
+}
+
+func (m *LinkedHashMap[K, V]) Empty() bool {
+	return m.Size() == 0
+}
+
+func (m *LinkedHashMap[K, V]) Size() int {
+	return len(m.table)
+}
+
+func (m *LinkedHashMap[K, V]) Get(key K) (V, bool) {
+	if n, ok := m.table[key]; ok {
+		return n.value.value, true
+	}
+	return *new(V), false
+}
+
+// Put associates value to key, a new key goes to the back of the map.
+func (m *LinkedHashMap[K, V]) Put(key K, value V) {
+	if n, ok := m.table[key]; ok {
+		// entries handed out by the iterators stay unchanged
+		n.value = NewEntry(key, value)
+		return
+	}
+	m.table[key] = m.order.linkBefore(NewEntry(key, value), nil)
+}
+
+func (m *LinkedHashMap[K, V]) ContainsKey(key K) bool {
+	_, ok := m.table[key]
+	return ok
+}
+
+func (m *LinkedHashMap[K, V]) ContainsValue(value V) bool {
+	eq := m.eq()
+	for n := m.order.head; n != nil; n = n.next {
+		if eq(n.value.value, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *LinkedHashMap[K, V]) Delete(key K) bool {
+	n, ok := m.table[key]
+	if ok {
+		delete(m.table, key)
+		m.order.unlink(n)
+	}
+	return ok
+}
+
+// Keys returns the keys of the map in insertion order as a LinkedHashSet.
+func (m *LinkedHashMap[K, V]) Keys() Set[K] {
+	set := NewLinkedHashSet[K]()
+	for n := m.order.head; n != nil; n = n.next {
+		set.Push(n.value.key)
+	}
+	return set
+}
+
+// Values returns the values of the map in insertion order of their keys.
+func (m *LinkedHashMap[K, V]) Values() Collection[V] {
+	lst := NewSlice[V]()
+	for n := m.order.head; n != nil; n = n.next {
+		lst.PushBack(n.value.value)
+	}
+	return lst
+}
+
+// EntryList returns the entries of the map in insertion order.
+func (m *LinkedHashMap[K, V]) EntryList() Collection[*Entry[K, V]] {
+	lst := NewSlice[*Entry[K, V]]()
+	for n := m.order.head; n != nil; n = n.next {
+		lst.PushBack(n.value)
+	}
+	return lst
+}
+
+// Front returns the oldest entry of the map, but
+// if map is empty well this method return ErrEmptyCollection error.
+func (m *LinkedHashMap[K, V]) Front() (*Entry[K, V], error) {
+	if m.Empty() {
+		return nil, ErrEmptyCollection
+	}
+	return m.order.head.value, nil
+}
+
+// Back returns the newest entry of the map, but
+// if map is empty well this method return ErrEmptyCollection error.
+func (m *LinkedHashMap[K, V]) Back() (*Entry[K, V], error) {
+	if m.Empty() {
+		return nil, ErrEmptyCollection
+	}
+	return m.order.tail.value, nil
+}
+
+// MoveToFront moves key to the front of the map and reports whether it is in the map.
+func (m *LinkedHashMap[K, V]) MoveToFront(key K) bool {
+	n, ok := m.table[key]
+	if ok {
+		m.order.moveBefore(n, m.order.head)
+	}
+	return ok
+}
+
+// MoveToBack moves key to the back of the map and reports whether it is in the map.
+func (m *LinkedHashMap[K, V]) MoveToBack(key K) bool {
+	n, ok := m.table[key]
+	if ok && n != m.order.tail {
+		m.order.moveBefore(n, nil)
+	}
+	return ok
+}
+
+func (m *LinkedHashMap[K, V]) String() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	for n := m.order.head; n != nil; n = n.next {
+		var s string
+		if n.next == nil {
+			s = fmt.Sprintf("%v", n.value)
+		} else {
+			s = fmt.Sprintf("%v, ", n.value)
+		}
+		sb.WriteString(s)
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
@@ -0,0 +1,165 @@
+package collection
+
+import (
+	"slices"
+	"testing"
+)
+
+func lhmKeys[K comparable, V any](m *LinkedHashMap[K, V]) []K {
+	var keys []K
+	for k := range m.All() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func TestLinkedHashMap_Put(t *testing.T) {
+	useCases := []struct {
+		description string
+		entries     []*Entry[string, int]
+		keys        []string
+		values      []int
+	}{
+		{description: "empty map"},
+		{description: "insertion order", entries: []*Entry[string, int]{NewEntry("c", 1), NewEntry("a", 2), NewEntry("b", 3)}, keys: []string{"c", "a", "b"}, values: []int{1, 2, 3}},
+		{description: "put existing key keeps its place", entries: []*Entry[string, int]{NewEntry("c", 1), NewEntry("a", 2), NewEntry("c", 3)}, keys: []string{"c", "a"}, values: []int{3, 2}},
+	}
+
+	for _, tt := range useCases {
+		m := NewLinkedHashMap(tt.entries...)
+		keys := slices.Collect(Seq(m.Keys().Iterator()))
+		values := slices.Collect(Seq(m.Values().Iterator()))
+		if !slices.Equal(keys, tt.keys) || !slices.Equal(values, tt.values) || m.Size() != len(tt.keys) {
+			t.Errorf("test: %s want (%v, %v) got (%v, %v)", tt.description, tt.keys, tt.values, keys, values)
+		}
+		var entries []string
+		for it := m.EntryList().Iterator(); it.HasNext(); {
+			entries = append(entries, it.Next().key)
+		}
+		if !slices.Equal(entries, tt.keys) {
+			t.Errorf("test: %s entries want %v got %v", tt.description, tt.keys, entries)
+		}
+	}
+}
+
+func TestLinkedHashMap_Get(t *testing.T) {
+	m := NewLinkedHashMap(NewEntry("a", 1), NewEntry("b", 2))
+	useCases := []struct {
+		description string
+		key         string
+		want        int
+		ok          bool
+	}{
+		{description: "present key", key: "b", want: 2, ok: true},
+		{description: "missing key", key: "c"},
+	}
+
+	for _, tt := range useCases {
+		result, ok := m.Get(tt.key)
+		if result != tt.want || ok != tt.ok || m.ContainsKey(tt.key) != tt.ok {
+			t.Errorf("test: %s want (%v, %v) got (%v, %v)", tt.description, tt.want, tt.ok, result, ok)
+		}
+	}
+	if !m.ContainsValue(2) || m.ContainsValue(3) {
+		t.Errorf("test: ContainsValue want (true, false) got (%v, %v)", m.ContainsValue(2), m.ContainsValue(3))
+	}
+}
+
+func TestLinkedHashMap_Delete(t *testing.T) {
+	useCases := []struct {
+		description string
+		key         string
+		deleted     bool
+		want        []string
+	}{
+		{description: "delete missing key", key: "d", want: []string{"a", "b", "c"}},
+		{description: "delete first key", key: "a", deleted: true, want: []string{"b", "c"}},
+		{description: "delete middle key", key: "b", deleted: true, want: []string{"a", "c"}},
+		{description: "delete last key", key: "c", deleted: true, want: []string{"a", "b"}},
+	}
+
+	for _, tt := range useCases {
+		m := NewLinkedHashMap(NewEntry("a", 1), NewEntry("b", 2), NewEntry("c", 3))
+		deleted := m.Delete(tt.key)
+		if keys := lhmKeys(m); !slices.Equal(keys, tt.want) || deleted != tt.deleted || m.ContainsKey(tt.key) {
+			t.Errorf("test: %s want (%v, %v) got (%v, %v)", tt.description, tt.want, tt.deleted, keys, deleted)
+		}
+	}
+}
+
+func TestLinkedHashMap_Move(t *testing.T) {
+	useCases := []struct {
+		description string
+		move        func(m *LinkedHashMap[string, int]) bool
+		found       bool
+		want        []string
+	}{
+		{description: "move to front", move: func(m *LinkedHashMap[string, int]) bool { return m.MoveToFront("c") }, found: true, want: []string{"c", "a", "b"}},
+		{description: "move to back", move: func(m *LinkedHashMap[string, int]) bool { return m.MoveToBack("a") }, found: true, want: []string{"b", "c", "a"}},
+		{description: "move missing key", move: func(m *LinkedHashMap[string, int]) bool { return m.MoveToFront("d") }, want: []string{"a", "b", "c"}},
+	}
+
+	for _, tt := range useCases {
+		m := NewLinkedHashMap(NewEntry("a", 1), NewEntry("b", 2), NewEntry("c", 3))
+		found := tt.move(m)
+		if keys := lhmKeys(m); !slices.Equal(keys, tt.want) || found != tt.found {
+			t.Errorf("test: %s want (%v, %v) got (%v, %v)", tt.description, tt.want, tt.found, keys, found)
+		}
+		front, _ := m.Front()
+		back, _ := m.Back()
+		if front.key != tt.want[0] || back.key != tt.want[len(tt.want)-1] {
+			t.Errorf("test: %s want front %s back %s got %s %s", tt.description, tt.want[0], tt.want[len(tt.want)-1], front.key, back.key)
+		}
+	}
+
+	if _, err := NewLinkedHashMap[string, int]().Front(); err != ErrEmptyCollection {
+		t.Errorf("test: front of empty map want %v got %v", ErrEmptyCollection, err)
+	}
+}
+
+func TestLinkedHashMap_Iterator(t *testing.T) {
+	m := NewLinkedHashMap(NewEntry("a", 1), NewEntry("b", 2), NewEntry("c", 3))
+	first := m.Iterator().Next()
+	m.Put("a", 10)
+	if first.value != 1 {
+		t.Errorf("test: entry after put want %d got %d", 1, first.value)
+	}
+
+	it := m.Iterator().(MutableIterator[*Entry[string, int]])
+	for it.HasNext() {
+		if it.Next().key == "b" {
+			if err := it.Remove(); err != nil {
+				t.Errorf("test: remove want no error got %v", err)
+			}
+		}
+	}
+	if keys := lhmKeys(m); !slices.Equal(keys, []string{"a", "c"}) || m.ContainsKey("b") || m.Size() != 2 {
+		t.Errorf("test: remove while iterating want %v got %v", []string{"a", "c"}, keys)
+	}
+
+	err := modificationPanic(func() {
+		for range m.All() {
+			m.Put("d", 4)
+		}
+	})
+	if _, ok := err.(ErrConcurrentModification); !ok {
+		t.Errorf("test: put while ranging want %T got %v", ErrConcurrentModification{}, err)
+	}
+}
+
+func TestLinkedHashMap_String(t *testing.T) {
+	useCases := []struct {
+		description string
+		m           *LinkedHashMap[string, int]
+		want        string
+	}{
+		{description: "empty map", m: NewLinkedHashMap[string, int](), want: "{}"},
+		{description: "insertion order", m: NewLinkedHashMap(NewEntry("b", 1), NewEntry("a", 2)), want: "{[[b, 1]], [[a, 2]]}"},
+	}
+
+	for _, tt := range useCases {
+		if result := tt.m.String(); result != tt.want {
+			t.Errorf("test: %s want %s got %s", tt.description, tt.want, result)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package collection
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// LinkedHashSet is a hash set that remembers the order its items were
+// pushed in, pushing an item already in the set does not change its place.
+// MoveToFront and MoveToBack reorder the items, for example to keep them in
+// access order. Every operation takes O(1).
+type LinkedHashSet[E comparable] struct {
+	table map[E]*node[E]
+	order *LinkedList[E]
+}
+
+func NewLinkedHashSet[E comparable](items ...E) *LinkedHashSet[E] {
+	set := &LinkedHashSet[E]{table: make(map[E]*node[E]), order: NewLinkedList[E]()}
+	for _, item := range items {
+		set.Push(item)
+	}
+	return set
+}
+
+// Iterator returns an iterator over the items of the set in insertion order,
+// it is a MutableIterator.
+func (h *LinkedHashSet[E]) Iterator() Iterator[E] {
+	if h.Empty() {
+		return &emptyListIterator[E]{}
+	}
+	return &linkedHashIterator[E]{
+		it: &listIterator[E]{currentNode: h.order.head, list: h.order, expected: h.order.mods},
+		remove: func(item E) {
+			delete(h.table, item)
+		},
+	}
+}
+
+// All returns a sequence over the items of the set in insertion order.
+func (h *LinkedHashSet[E]) All() iter.Seq[E] {
+	return h.order.All()
+}
+
+// Enumerate returns a sequence over the items of the set in insertion order along with their position.
+func (h *LinkedHashSet[E]) Enumerate() iter.Seq2[int, E] {
+	return enumerate(h.All())
+}
+
+func (h *LinkedHashSet[E]) Empty() bool {
+	return h.Size() == 0
+}
+
+func (h *LinkedHashSet[E]) Size() int {
+	return len(h.table)
+}
+
+// Push adds item at the back of the set if it is not there yet.
+func (h *LinkedHashSet[E]) Push(item E) {
+	if _, ok := h.table[item]; !ok {
+		h.table[item] = h.order.linkBefore(item, nil)
+	}
+}
+
+func (h *LinkedHashSet[E]) Contains(item E) bool {
+	_, ok := h.table[item]
+	return ok
+}
+
+func (h *LinkedHashSet[E]) Delete(item E) error {
+	if n, ok := h.table[item]; ok {
+		delete(h.table, item)
+		h.order.unlink(n)
+	}
+	return nil
+}
+
+// Front returns the oldest item of the set, but
+// if set is empty well this method return ErrEmptyCollection error.
+func (h *LinkedHashSet[E]) Front() (E, error) {
+	if h.Empty() {
+		return *new(E), ErrEmptyCollection
+	}
+	return h.order.head.value, nil
+}
+
+// Back returns the newest item of the set, but
+// if set is empty well this method return ErrEmptyCollection error.
+func (h *LinkedHashSet[E]) Back() (E, error) {
+	if h.Empty() {
+		return *new(E), ErrEmptyCollection
+	}
+	return h.order.tail.value, nil
+}
+
+// MoveToFront moves item to the front of the set and reports whether it is in the set.
+func (h *LinkedHashSet[E]) MoveToFront(item E) bool {
+	n, ok := h.table[item]
+	if ok {
+		h.order.moveBefore(n, h.order.head)
+	}
+	return ok
+}
+
+// MoveToBack moves item to the back of the set and reports whether it is in the set.
+func (h *LinkedHashSet[E]) MoveToBack(item E) bool {
+	n, ok := h.table[item]
+	if ok && n != h.order.tail {
+		h.order.moveBefore(n, nil)
+	}
+	return ok
+}
+
+func (h *LinkedHashSet[E]) String() string {
+	var sb strings.Builder
+	sb.WriteString("[")
+	for i, item := range h.Enumerate() {
+		var s string
+		if i >= h.Size()-1 {
+			s = fmt.Sprintf("%v", item)
+		} else {
+			s = fmt.Sprintf("%v, ", item)
+		}
+		sb.WriteString(s)
+	}
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// linkedHashIterator walks the order list of a linked hash set or map and
+// also removes from its table the items deleted with Remove.
+type linkedHashIterator[E any] struct {
+	it     *listIterator[E]
+	remove func(item E)
+}
+
+func (it *linkedHashIterator[E]) HasNext() bool {
+	return it.it.HasNext()
+}
+
+func (it *linkedHashIterator[E]) Next() E {
+	return it.it.Next()
+}
+
+func (it *linkedHashIterator[E]) NextWithIndex() (int, E) {
+	return it.it.NextWithIndex()
+}
+
+func (it *linkedHashIterator[E]) Remove() error {
+	if it.it.lastReturned == nil {
+		return ErrIllegalIteratorState
+	}
+	item := it.it.lastReturned.value
+	if err := it.it.Remove(); err != nil {
+		return err
+	}
+	it.remove(item)
+	return nil
+}
@@ -0,0 +1,128 @@
+package collection
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLinkedHashSet_Push(t *testing.T) {
+	useCases := []struct {
+		description string
+		items       []int
+		want        []int
+	}{
+		{description: "empty set", items: []int{}, want: []int{}},
+		{description: "insertion order", items: []int{3, 1, 2}, want: []int{3, 1, 2}},
+		{description: "duplicates keep their place", items: []int{3, 1, 3, 2, 1}, want: []int{3, 1, 2}},
+	}
+
+	for _, tt := range useCases {
+		set := NewLinkedHashSet(tt.items...)
+		if result := slices.Collect(Seq(set.Iterator())); !slices.Equal(result, tt.want) || set.Size() != len(tt.want) {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.want, result)
+		}
+	}
+}
+
+func TestLinkedHashSet_Delete(t *testing.T) {
+	useCases := []struct {
+		description string
+		items       []int
+		item        int
+		want        []int
+	}{
+		{description: "delete missing item", items: []int{1, 2}, item: 3, want: []int{1, 2}},
+		{description: "delete first item", items: []int{1, 2, 3}, item: 1, want: []int{2, 3}},
+		{description: "delete middle item", items: []int{1, 2, 3}, item: 2, want: []int{1, 3}},
+		{description: "delete last item", items: []int{1, 2, 3}, item: 3, want: []int{1, 2}},
+	}
+
+	for _, tt := range useCases {
+		set := NewLinkedHashSet(tt.items...)
+		if err := set.Delete(tt.item); err != nil {
+			t.Errorf("test: %s want no error got %v", tt.description, err)
+		}
+		if result := slices.Collect(set.All()); !slices.Equal(result, tt.want) || set.Contains(tt.item) {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.want, result)
+		}
+	}
+}
+
+func TestLinkedHashSet_Move(t *testing.T) {
+	useCases := []struct {
+		description string
+		move        func(set *LinkedHashSet[int]) bool
+		found       bool
+		want        []int
+	}{
+		{description: "move to front", move: func(set *LinkedHashSet[int]) bool { return set.MoveToFront(3) }, found: true, want: []int{3, 1, 2}},
+		{description: "move front to front", move: func(set *LinkedHashSet[int]) bool { return set.MoveToFront(1) }, found: true, want: []int{1, 2, 3}},
+		{description: "move to back", move: func(set *LinkedHashSet[int]) bool { return set.MoveToBack(1) }, found: true, want: []int{2, 3, 1}},
+		{description: "move back to back", move: func(set *LinkedHashSet[int]) bool { return set.MoveToBack(3) }, found: true, want: []int{1, 2, 3}},
+		{description: "move missing item", move: func(set *LinkedHashSet[int]) bool { return set.MoveToBack(4) }, want: []int{1, 2, 3}},
+	}
+
+	for _, tt := range useCases {
+		set := NewLinkedHashSet(1, 2, 3)
+		found := tt.move(set)
+		if result := slices.Collect(set.All()); !slices.Equal(result, tt.want) || found != tt.found {
+			t.Errorf("test: %s want (%v, %v) got (%v, %v)", tt.description, tt.want, tt.found, result, found)
+		}
+		front, _ := set.Front()
+		back, _ := set.Back()
+		if front != tt.want[0] || back != tt.want[len(tt.want)-1] {
+			t.Errorf("test: %s want front %d back %d got %d %d", tt.description, tt.want[0], tt.want[len(tt.want)-1], front, back)
+		}
+	}
+
+	if _, err := NewLinkedHashSet[int]().Front(); err != ErrEmptyCollection {
+		t.Errorf("test: front of empty set want %v got %v", ErrEmptyCollection, err)
+	}
+	if _, err := NewLinkedHashSet[int]().Back(); err != ErrEmptyCollection {
+		t.Errorf("test: back of empty set want %v got %v", ErrEmptyCollection, err)
+	}
+}
+
+func TestLinkedHashSet_IteratorRemove(t *testing.T) {
+	set := NewLinkedHashSet(1, 2, 3, 4)
+	it := set.Iterator().(MutableIterator[int])
+	if err := it.Remove(); err != ErrIllegalIteratorState {
+		t.Errorf("test: remove before next want %v got %v", ErrIllegalIteratorState, err)
+	}
+	for it.HasNext() {
+		if it.Next()%2 == 0 {
+			if err := it.Remove(); err != nil {
+				t.Errorf("test: remove want no error got %v", err)
+			}
+		}
+	}
+	if result := slices.Collect(set.All()); !slices.Equal(result, []int{1, 3}) || set.Contains(2) || set.Size() != 2 {
+		t.Errorf("test: remove even items want %v got %v", []int{1, 3}, result)
+	}
+
+	err := modificationPanic(func() {
+		for it := set.Iterator(); it.HasNext(); {
+			set.MoveToBack(it.Next())
+		}
+	})
+	if _, ok := err.(ErrConcurrentModification); !ok {
+		t.Errorf("test: move while iterating want %T got %v", ErrConcurrentModification{}, err)
+	}
+}
+
+func TestLinkedHashSet_String(t *testing.T) {
+	useCases := []struct {
+		description string
+		set         *LinkedHashSet[int]
+		want        string
+	}{
+		{description: "empty set", set: NewLinkedHashSet[int](), want: "[]"},
+		{description: "insertion order", set: NewLinkedHashSet(3, 1, 2), want: "[3, 1, 2]"},
+	}
+
+	for _, tt := range useCases {
+		if result := tt.set.String(); result != tt.want {
+			t.Errorf("test: %s want %s got %s", tt.description, tt.want, result)
+		}
+	}
+}
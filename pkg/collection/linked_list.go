@@ -2,7 +2,7 @@ package collection
 
 import (
 	"fmt"
-	"reflect"
+	"iter"
 	"strings"
 )
 
@@ -15,6 +15,9 @@ type node[E any] struct {
 type listIterator[E any] struct {
 	currentNode  *node[E]
 	currentIndex int
+	lastReturned *node[E]
+	list         *LinkedList[E]
+	expected     int
 }
 
 func (it *listIterator[E]) HasNext() bool {
@@ -22,20 +25,76 @@ func (it *listIterator[E]) HasNext() bool {
 }
 
 func (it *listIterator[E]) Next() E {
-	curr := it.currentNode
-	it.currentNode = curr.next
-	it.currentIndex++
-	return curr.value
+	_, item := it.NextWithIndex()
+	return item
 }
 
 func (it *listIterator[E]) NextWithIndex() (int, E) {
+	checkModifications(it.expected, it.list.mods)
 	curr := it.currentNode
 	index := it.currentIndex
+	it.lastReturned = curr
 	it.currentNode = curr.next
 	it.currentIndex++
 	return index, curr.value
 }
 
+func (it *listIterator[E]) HasPrevious() bool {
+	return it.currentIndex > 0
+}
+
+func (it *listIterator[E]) Previous() E {
+	checkModifications(it.expected, it.list.mods)
+	if !it.HasPrevious() {
+		panic(ErrIllegalIteratorState)
+	}
+	if it.currentNode == nil {
+		it.currentNode = it.list.tail
+	} else {
+		it.currentNode = it.currentNode.prev
+	}
+	it.lastReturned = it.currentNode
+	it.currentIndex--
+	return it.currentNode.value
+}
+
+// Remove deletes the item returned by the last call to Next or Previous in O(1).
+func (it *listIterator[E]) Remove() error {
+	if it.lastReturned == nil {
+		return ErrIllegalIteratorState
+	}
+	checkModifications(it.expected, it.list.mods)
+	if it.lastReturned == it.currentNode {
+		// removing the item returned by Previous, the cursor stays in place
+		it.currentNode = it.lastReturned.next
+	} else {
+		it.currentIndex--
+	}
+	it.list.unlink(it.lastReturned)
+	it.lastReturned = nil
+	it.expected = it.list.mods
+	return nil
+}
+
+// Set replaces the item returned by the last call to Next or Previous in O(1).
+func (it *listIterator[E]) Set(item E) error {
+	if it.lastReturned == nil {
+		return ErrIllegalIteratorState
+	}
+	checkModifications(it.expected, it.list.mods)
+	it.lastReturned.value = item
+	return nil
+}
+
+// Add inserts item before the cursor in O(1), a following call to Next is not affected.
+func (it *listIterator[E]) Add(item E) {
+	checkModifications(it.expected, it.list.mods)
+	it.list.linkBefore(item, it.currentNode)
+	it.currentIndex++
+	it.lastReturned = nil
+	it.expected = it.list.mods
+}
+
 type emptyListIterator[E any] struct{}
 
 func (it *emptyListIterator[E]) HasNext() bool {
@@ -50,9 +109,16 @@ func (it *emptyListIterator[E]) NextWithIndex() (int, E) {
 	return 0, *new(E)
 }
 
+func (it *emptyListIterator[E]) Remove() error {
+	return ErrIllegalIteratorState
+}
+
 type LinkedList[E any] struct {
-	head *node[E]
-	size int
+	head  *node[E]
+	tail  *node[E]
+	size  int
+	equal EqualFunc[E]
+	mods  int
 }
 
 func NewLinkedList[E any](items ...E) *LinkedList[E] {
@@ -63,11 +129,64 @@ func NewLinkedList[E any](items ...E) *LinkedList[E] {
 	return lst
 }
 
+// NewLinkedListWith creates a linked list configured with the given options,
+// it holds the items given by WithItems.
+func NewLinkedListWith[E any](opts ...Option[E]) *LinkedList[E] {
+	o := newOptions(opts...)
+	lst := &LinkedList[E]{equal: o.equal}
+	for _, item := range o.items {
+		lst.PushBack(item)
+	}
+	return lst
+}
+
+func (l *LinkedList[E]) eq() EqualFunc[E] {
+	if l.equal == nil {
+		return DefaultEqual[E]()
+	}
+	return l.equal
+}
+
 func (l *LinkedList[E]) Iterator() Iterator[E] {
 	if l.Empty() {
 		return &emptyListIterator[E]{}
 	}
-	return &listIterator[E]{l.head, 0}
+	return &listIterator[E]{currentNode: l.head, list: l, expected: l.mods}
+}
+
+// ListIterator returns a ListIterator whose first call to Next returns the
+// item at startPos, but if startPos is not between 0 and the size of the
+// list well this method return ErrPositionNegative or ErrIndexOutOfBound error.
+func (l *LinkedList[E]) ListIterator(startPos int) (ListIterator[E], error) {
+	if startPos < 0 {
+		return nil, ErrPositionNegative
+	}
+	if startPos > l.size {
+		return nil, ErrIndexOutOfBound{startPos, l.size}
+	}
+	it := &listIterator[E]{list: l, currentIndex: startPos, expected: l.mods}
+	if startPos < l.size {
+		it.currentNode, _ = l.findNode(startPos)
+	}
+	return it, nil
+}
+
+// All returns a sequence over the items of the list from the head to the tail.
+func (l *LinkedList[E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		mods := l.mods
+		for n := l.head; n != nil; n = n.next {
+			if !yield(n.value) {
+				return
+			}
+			checkModifications(mods, l.mods)
+		}
+	}
+}
+
+// Enumerate returns a sequence over the items of the list from the head to the tail along with their position.
+func (l *LinkedList[E]) Enumerate() iter.Seq2[int, E] {
+	return enumerate(l.All())
 }
 
 func (l *LinkedList[E]) Empty() bool {
@@ -94,13 +213,13 @@ func (l *LinkedList[E]) GetAt(pos int) (E, error) {
 	return node.value, nil
 }
 
-// findNode returns node at given position from linked list
+// findNode returns node at given position from linked list,
+// it walks from the head or from the tail whichever is closer.
 func (l *LinkedList[E]) findNode(pos int) (*node[E], error) {
 	if l.Empty() {
 		return nil, ErrEmptyCollection
 	}
 
-	ptr := l.head
 	if pos < 0 {
 		return nil, ErrPositionNegative
 	}
@@ -109,17 +228,26 @@ func (l *LinkedList[E]) findNode(pos int) (*node[E], error) {
 		return nil, ErrIndexOutOfBound{pos, l.size}
 	}
 
-	for i := 0; i < pos; i++ {
-		ptr = ptr.next
+	if pos < l.size/2 {
+		ptr := l.head
+		for i := 0; i < pos; i++ {
+			ptr = ptr.next
+		}
+		return ptr, nil
 	}
 
+	ptr := l.tail
+	for i := l.size - 1; i > pos; i-- {
+		ptr = ptr.prev
+	}
 	return ptr, nil
 }
 
 func (l *LinkedList[E]) Contains(item E) bool {
+	eq := l.eq()
 	for it := l.Iterator(); it.HasNext(); {
 		x := it.Next()
-		if reflect.DeepEqual(item, x) {
+		if eq(x, item) {
 			return true
 		}
 	}
@@ -127,9 +255,10 @@ func (l *LinkedList[E]) Contains(item E) bool {
 }
 
 func (l *LinkedList[E]) Index(item E) (int, error) {
+	eq := l.eq()
 	for it := l.Iterator(); it.HasNext(); {
 		i, x := it.NextWithIndex()
-		if reflect.DeepEqual(item, x) {
+		if eq(x, item) {
 			return i, nil
 		}
 	}
@@ -150,38 +279,69 @@ func (l *LinkedList[E]) PushBack(item E) {
 
 // PushAt inserts new node at given position
 func (l *LinkedList[E]) PushAt(item E, pos int) {
-	// create a new node
-	newNode := node[E]{value: item}
-
 	// validate the position
-	if pos < 0 {
+	if pos < 0 || pos > l.size {
 		return
 	}
 
-	if pos == 0 {
-		oldNode := l.head
-		newNode.next = oldNode
-		l.head = &newNode
-		if oldNode != nil {
-			oldNode.prev = l.head
-		}
-		l.size++
-		return
-	}
-	if pos > l.size {
+	if pos == l.size {
+		l.linkBefore(item, nil)
 		return
 	}
 	n, _ := l.findNode(pos)
+	l.linkBefore(item, n)
+}
+
+// linkBefore inserts a new node holding item before n, or at the back when n is nil.
+func (l *LinkedList[E]) linkBefore(item E, n *node[E]) *node[E] {
+	newNode := &node[E]{value: item}
+	l.link(newNode, n)
+	return newNode
+}
+
+// link inserts the detached node newNode before n, or at the back when n is nil.
+func (l *LinkedList[E]) link(newNode, n *node[E]) {
 	newNode.next = n
-	var prevNode *node[E]
-	if n != nil {
-		prevNode = n.prev
+	if n == nil {
+		newNode.prev = l.tail
+		l.tail = newNode
 	} else {
-		prevNode, _ = l.findNode(pos - 1)
+		newNode.prev = n.prev
+		n.prev = newNode
+	}
+	if newNode.prev == nil {
+		l.head = newNode
+	} else {
+		newNode.prev.next = newNode
 	}
-	prevNode.next = &newNode
-	newNode.prev = prevNode
 	l.size++
+	l.mods++
+}
+
+// moveBefore moves n before at, or to the back when at is nil.
+func (l *LinkedList[E]) moveBefore(n, at *node[E]) {
+	if n == at {
+		return
+	}
+	l.unlink(n)
+	l.link(n, at)
+}
+
+// unlink removes n from the list.
+func (l *LinkedList[E]) unlink(n *node[E]) {
+	if n.prev == nil {
+		l.head = n.next
+	} else {
+		n.prev.next = n.next
+	}
+	if n.next == nil {
+		l.tail = n.prev
+	} else {
+		n.next.prev = n.prev
+	}
+	n.prev, n.next = nil, nil
+	l.size--
+	l.mods++
 }
 
 func (l *LinkedList[E]) Delete(item E) error {
@@ -203,33 +363,39 @@ func (l *LinkedList[E]) DeleteAt(pos int) error {
 		return ErrEmptyCollection
 	}
 
-	if pos == 0 {
-		// For first position not exists prev node
-		myNode, err := l.findNode(pos)
-		if err != nil {
-			return err
-		}
-		l.head = myNode.next
-		l.head.prev = nil
-	} else {
-		prevNode, _ := l.findNode(pos - 1)
-		if prevNode == nil {
+	myNode, err := l.findNode(pos)
+	if err != nil {
+		if pos > l.size {
 			return ErrNodeNotFound
 		}
-		myNode, err := l.findNode(pos)
-		if err != nil {
-			return err
-		}
-		prevNode.next = myNode.next
-		if myNode.next != nil {
-			myNode.next.prev = prevNode
-		}
+		return err
 	}
-
-	l.size--
+	l.unlink(myNode)
 	return nil
 }
 
+// PopFront get and remove the first item of the list, but
+// if list is empty well this method return ErrEmptyCollection error.
+func (l *LinkedList[E]) PopFront() (E, error) {
+	if l.Empty() {
+		return *new(E), ErrEmptyCollection
+	}
+	n := l.head
+	l.unlink(n)
+	return n.value, nil
+}
+
+// PopBack get and remove the last item of the list, but
+// if list is empty well this method return ErrEmptyCollection error.
+func (l *LinkedList[E]) PopBack() (E, error) {
+	if l.Empty() {
+		return *new(E), ErrEmptyCollection
+	}
+	n := l.tail
+	l.unlink(n)
+	return n.value, nil
+}
+
 func (l *LinkedList[E]) Set(item E, pos int) error {
 	n, err := l.findNode(pos)
 	if err != nil {
@@ -1,6 +1,8 @@
 package collection
 
 import (
+	"fmt"
+	"reflect"
 	"testing"
 )
 
@@ -421,3 +423,106 @@ func compareLists(lst1, lst2 *LinkedList[int]) bool {
 	}
 	return true
 }
+
+func TestLinkedList_Pop(t *testing.T) {
+	useCases := []struct {
+		description string
+		original    *LinkedList[int]
+		front       int
+		back        int
+		modified    *LinkedList[int]
+		err         error
+	}{
+		{description: "pop on empty list",
+			original: NewLinkedList[int](),
+			modified: NewLinkedList[int](),
+			err:      ErrEmptyCollection},
+		{description: "pop on list with items",
+			original: NewLinkedList(1, 2, 3, 4),
+			front:    1,
+			back:     4,
+			modified: NewLinkedList(2, 3),
+			err:      nil},
+	}
+
+	for _, tt := range useCases {
+		front, err1 := tt.original.PopFront()
+		back, err2 := tt.original.PopBack()
+		if front != tt.front || back != tt.back || err1 != tt.err || err2 != tt.err ||
+			!compareLists(tt.original, tt.modified) || tt.original.Size() != tt.modified.Size() {
+			t.Errorf("test: %s want {%v, %v, %v} got {%v, %v, %v}", tt.description, tt.front, tt.back, tt.modified, front, back, tt.original)
+		}
+	}
+}
+
+func TestLinkedList_Links(t *testing.T) {
+	lst := NewLinkedList(1, 2, 3, 4, 5)
+	lst.DeleteAt(4)
+	lst.PushAt(6, 1)
+	lst.DeleteAt(0)
+	lst.PushBack(7)
+	lst.PopFront()
+	want := []int{2, 3, 4, 7}
+
+	var forward, backward []int
+	for n := lst.head; n != nil; n = n.next {
+		forward = append(forward, n.value)
+	}
+	for n := lst.tail; n != nil; n = n.prev {
+		backward = append([]int{n.value}, backward...)
+	}
+	if !reflect.DeepEqual(forward, want) || !reflect.DeepEqual(backward, want) {
+		t.Errorf("test: links want %v got forward %v backward %v", want, forward, backward)
+	}
+	for i, item := range want {
+		if result, _ := lst.GetAt(i); result != item {
+			t.Errorf("test: get at %d want %v got %v", i, item, result)
+		}
+	}
+}
+
+func BenchmarkLinkedList_NewLinkedList(b *testing.B) {
+	items := make([]int, 10000)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		NewLinkedList(items...)
+	}
+}
+
+func BenchmarkLinkedList_PushPopBack(b *testing.B) {
+	for _, size := range []int{10, 10000} {
+		lst := NewLinkedList(make([]int, size)...)
+		b.Run(fmt.Sprintf("size %d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				lst.PushBack(i)
+				lst.Back()
+				lst.PopBack()
+			}
+		})
+	}
+}
+
+func BenchmarkLinkedList_PushPopFront(b *testing.B) {
+	for _, size := range []int{10, 10000} {
+		lst := NewLinkedList(make([]int, size)...)
+		b.Run(fmt.Sprintf("size %d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				lst.PushFront(i)
+				lst.Front()
+				lst.PopFront()
+			}
+		})
+	}
+}
+
+func BenchmarkLinkedList_DeleteLast(b *testing.B) {
+	for _, size := range []int{10, 10000} {
+		lst := NewLinkedList(make([]int, size)...)
+		b.Run(fmt.Sprintf("size %d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				lst.DeleteAt(lst.Size() - 1)
+				lst.PushBack(i)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package collection
+
+// ListMultiMap is a map from a key to a list of values, backed by a HashMap
+// of Slice. The same pair can be put several times and the values of a key
+// keep the order they were put in. It is not safe for concurrent use.
+type ListMultiMap[K comparable, V any] struct {
+	*multiMap[K, V, *Slice[V]]
+}
+
+func NewListMultiMap[K comparable, V any]() *ListMultiMap[K, V] {
+	return NewListMultiMapWith[K, V]()
+}
+
+// NewListMultiMapWith creates an empty list multimap configured with the
+// given value options, they are used by Remove and the Contains methods.
+func NewListMultiMapWith[K comparable, V any](opts ...Option[V]) *ListMultiMap[K, V] {
+	equal := WithEqual(newOptions(opts...).equal)
+	return &ListMultiMap[K, V]{newMultiMap[K, V](func(K) *Slice[V] {
+		return NewSliceWith(equal)
+	})}
+}
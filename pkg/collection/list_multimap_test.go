@@ -0,0 +1,42 @@
+package collection
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestListMultiMap_Duplicates(t *testing.T) {
+	m := NewListMultiMap[string, int]()
+	m.Put("a", 3)
+	m.Put("a", 1)
+	m.Put("a", 3)
+	if result := m.Get("a").ToSlice(); !slices.Equal(result, []int{3, 1, 3}) || m.Size() != 3 {
+		t.Errorf("test: put keeps duplicates in order want %v got %v", []int{3, 1, 3}, result)
+	}
+	m.Remove("a", 3)
+	if result := m.Get("a").ToSlice(); !slices.Equal(result, []int{1, 3}) || m.Size() != 2 {
+		t.Errorf("test: remove deletes the first occurrence want %v got %v", []int{1, 3}, result)
+	}
+	if result := m.RemoveAll("a").ToSlice(); !slices.Equal(result, []int{1, 3}) || !m.Empty() {
+		t.Errorf("test: remove all want %v got %v", []int{1, 3}, result)
+	}
+}
+
+func TestListMultiMap_With(t *testing.T) {
+	m := NewListMultiMapWith[string, int](WithEqual(func(a, b int) bool { return a%10 == b%10 }))
+	m.Put("a", 12)
+	if !m.ContainsEntry("a", 2) || !m.Remove("a", 22) || !m.Empty() {
+		t.Errorf("test: custom equality want [[a, 12]] found and removed got %v", m)
+	}
+}
+
+func TestListMultiMap_String(t *testing.T) {
+	m := NewListMultiMap[string, int]()
+	m.PutAll("a", NewSlice(1, 2))
+	if result := m.String(); result != "{[[a, [1, 2]]]}" {
+		t.Errorf("test: string want %v got %v", "{[[a, [1, 2]]]}", result)
+	}
+	if result := m.Get("b"); result.(interface{ String() string }).String() != "[]" {
+		t.Errorf("test: string of a missing key want %v got %v", "[]", result)
+	}
+}
@@ -0,0 +1,35 @@
+package collection
+
+// LRUCache is a cache that evicts the least recently used entry first, Get
+// and Put count as a use while Peek does not. Every operation takes O(1).
+// It is not safe for concurrent use, see SynchronizedCache.
+type LRUCache[K comparable, V any] struct {
+	*cache[K, V]
+}
+
+// NewLRUCache creates a cache of at most maxEntries entries, a maxEntries
+// below 1 means the count is not bounded and only the max cost, if any, is.
+func NewLRUCache[K comparable, V any](maxEntries int, opts ...CacheOption[K, V]) *LRUCache[K, V] {
+	return &LRUCache[K, V]{newCache(maxEntries, &lruPolicy[K]{order: NewLinkedHashSet[K]()}, opts)}
+}
+
+// lruPolicy keeps the keys from the least to the most recently used.
+type lruPolicy[K comparable] struct {
+	order *LinkedHashSet[K]
+}
+
+func (p *lruPolicy[K]) add(key K) {
+	p.order.Push(key)
+}
+
+func (p *lruPolicy[K]) touch(key K) {
+	p.order.MoveToBack(key)
+}
+
+func (p *lruPolicy[K]) remove(key K) {
+	p.order.Delete(key)
+}
+
+func (p *lruPolicy[K]) victim(keep K) (K, bool) {
+	return firstExcept(p.order, keep)
+}
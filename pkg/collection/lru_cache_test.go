@@ -0,0 +1,55 @@
+package collection
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLRUCache_Eviction(t *testing.T) {
+	useCases := []struct {
+		description string
+		ops         func(c *LRUCache[string, int])
+		evicted     []string
+	}{
+		{description: "oldest entry first", ops: func(c *LRUCache[string, int]) {
+			c.Put("d", 4)
+			c.Put("e", 5)
+		}, evicted: []string{"a", "b"}},
+		{description: "get refreshes an entry", ops: func(c *LRUCache[string, int]) {
+			c.Get("a")
+			c.Put("d", 4)
+		}, evicted: []string{"b"}},
+		{description: "put refreshes an entry", ops: func(c *LRUCache[string, int]) {
+			c.Put("a", 10)
+			c.Put("d", 4)
+		}, evicted: []string{"b"}},
+		{description: "peek does not refresh an entry", ops: func(c *LRUCache[string, int]) {
+			c.Peek("a")
+			c.Put("d", 4)
+		}, evicted: []string{"a"}},
+	}
+
+	for _, tt := range useCases {
+		var evicted []string
+		c := NewLRUCache(3, WithEvictionCallback(func(key string, _ int, _ EvictionReason) {
+			evicted = append(evicted, key)
+		}))
+		c.Put("a", 1)
+		c.Put("b", 2)
+		c.Put("c", 3)
+		tt.ops(c)
+		if !slices.Equal(evicted, tt.evicted) || c.Len() != 3 || c.Stats().Evictions != uint64(len(tt.evicted)) {
+			t.Errorf("test: %s want %v got %v len %d", tt.description, tt.evicted, evicted, c.Len())
+		}
+	}
+}
+
+func BenchmarkLRUCache_Put(b *testing.B) {
+	c := NewLRUCache[int, int](1000)
+	i := 0
+	for b.Loop() {
+		c.Put(i, i)
+		c.Get(i / 2)
+		i++
+	}
+}
@@ -0,0 +1,350 @@
+package collection
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// MultiMap maps a key to several values, see ListMultiMap and SetMultiMap.
+type MultiMap[K comparable, V any] interface {
+	// Put adds the pair key value and reports whether the map changed.
+	Put(key K, value V) bool
+	// PutAll adds a pair of key with every item of values and reports whether the map changed.
+	PutAll(key K, values Iterable[V]) bool
+	// Get returns a live view of the values of key.
+	Get(key K) Collection[V]
+	// Remove deletes the pair key value and reports whether it was in the map.
+	Remove(key K, value V) bool
+	// RemoveAll deletes every pair of key and returns their values.
+	RemoveAll(key K) Collection[V]
+	ContainsKey(key K) bool
+	ContainsValue(value V) bool
+	// ContainsEntry reports whether the pair key value is in the map.
+	ContainsEntry(key K, value V) bool
+	// KeySet returns the keys having at least one value.
+	KeySet() Set[K]
+	// Entries returns every pair of the map.
+	Entries() Collection[*Entry[K, V]]
+	Empty() bool
+	// Size returns the number of key value pairs, not the number of keys.
+	Size() int
+	Clear()
+}
+
+// multiMap holds what ListMultiMap and SetMultiMap have in common, they only
+// differ by the collection holding the values of a key. A key is in the map
+// only while it has at least one value, an emptied collection is dropped.
+type multiMap[K comparable, V any, C Collection[V]] struct {
+	table     *HashMap[K, C]
+	size      int
+	newValues func(key K) C
+}
+
+func newMultiMap[K comparable, V any, C Collection[V]](newValues func(key K) C) *multiMap[K, V, C] {
+	return &multiMap[K, V, C]{table: NewHashMap[K, C](), newValues: newValues}
+}
+
+// update runs fn on the values of key, when key has any, and keeps the size
+// and the table in sync with what fn did.
+func (m *multiMap[K, V, C]) update(key K, fn func(values C)) {
+	values, ok := m.table.Get(key)
+	if !ok {
+		return
+	}
+	before := values.Size()
+	fn(values)
+	m.size += values.Size() - before
+	if values.Empty() {
+		m.table.Delete(key)
+	}
+}
+
+// All returns a sequence over the pairs of the map, the keys come in random
+// order and the values of a key in the order of their collection.
+func (m *multiMap[K, V, C]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, values := range m.table.All() {
+			for it := values.Iterator(); it.HasNext(); {
+				if !yield(k, it.Next()) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (m *multiMap[K, V, C]) Empty() bool {
+	return m.Size() == 0
+}
+
+// Size returns the number of key value pairs, not the number of keys.
+func (m *multiMap[K, V, C]) Size() int {
+	return m.size
+}
+
+// Put adds the pair key value and reports whether the map changed.
+func (m *multiMap[K, V, C]) Put(key K, value V) bool {
+	values := m.table.ComputeIfAbsent(key, m.newValues)
+	before := values.Size()
+	values.Push(value)
+	m.size += values.Size() - before
+	return values.Size() != before
+}
+
+// PutAll adds a pair of key with every item of values and reports whether
+// the map changed.
+func (m *multiMap[K, V, C]) PutAll(key K, values Iterable[V]) bool {
+	items := iterableItems(values)
+	if len(items) == 0 {
+		return false
+	}
+	changed := false
+	for _, item := range items {
+		changed = m.Put(key, item) || changed
+	}
+	return changed
+}
+
+// Get returns a live view of the values of key, it is empty when key is not
+// in the map and follows the later changes of the map. Changes made through
+// the view go through to the map, Push on the view of a missing key adds it.
+func (m *multiMap[K, V, C]) Get(key K) Collection[V] {
+	return &multiMapValues[K, V, C]{m: m, key: key}
+}
+
+// Remove deletes the pair key value and reports whether it was in the map.
+func (m *multiMap[K, V, C]) Remove(key K, value V) bool {
+	removed := false
+	m.update(key, func(values C) {
+		// the size tells, HashSet.Delete does not report missing items
+		before := values.Size()
+		values.Delete(value)
+		removed = values.Size() < before
+	})
+	return removed
+}
+
+// RemoveAll deletes every pair of key and returns their values, which are
+// no longer tied to the map, or an empty collection when key is not in the map.
+func (m *multiMap[K, V, C]) RemoveAll(key K) Collection[V] {
+	values, ok := m.table.Get(key)
+	if !ok {
+		return m.newValues(key)
+	}
+	m.table.Delete(key)
+	m.size -= values.Size()
+	return values
+}
+
+func (m *multiMap[K, V, C]) ContainsKey(key K) bool {
+	return m.table.ContainsKey(key)
+}
+
+func (m *multiMap[K, V, C]) ContainsValue(value V) bool {
+	for _, values := range m.table.All() {
+		if values.Contains(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsEntry reports whether the pair key value is in the map.
+func (m *multiMap[K, V, C]) ContainsEntry(key K, value V) bool {
+	values, ok := m.table.Get(key)
+	return ok && values.Contains(value)
+}
+
+// KeySet returns the keys having at least one value.
+func (m *multiMap[K, V, C]) KeySet() Set[K] {
+	return m.table.Keys()
+}
+
+// Entries returns every pair of the map, a key appears once per value.
+func (m *multiMap[K, V, C]) Entries() Collection[*Entry[K, V]] {
+	lst := NewSlice[*Entry[K, V]]()
+	for k, v := range m.All() {
+		lst.PushBack(NewEntry(k, v))
+	}
+	return lst
+}
+
+func (m *multiMap[K, V, C]) Clear() {
+	m.table.Clear()
+	m.size = 0
+}
+
+func (m *multiMap[K, V, C]) String() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	first := true
+	for k, values := range m.table.All() {
+		if !first {
+			sb.WriteString(", ")
+		}
+		first = false
+		sb.WriteString(fmt.Sprintf("%v", NewEntry(k, values)))
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// multiMapValues is the view returned by Get, it looks the values of its key
+// up on every call so that it survives the key leaving and coming back.
+type multiMapValues[K comparable, V any, C Collection[V]] struct {
+	m   *multiMap[K, V, C]
+	key K
+}
+
+func (v *multiMapValues[K, V, C]) values() (C, bool) {
+	return v.m.table.Get(v.key)
+}
+
+// own copies items when they are the view itself, which changes while the
+// bulk operations run.
+func (v *multiMapValues[K, V, C]) own(items Iterable[V]) Iterable[V] {
+	if any(items) == any(v) {
+		return NewSlice(iterableItems(items)...)
+	}
+	return items
+}
+
+// Iterator returns an iterator over the values of the key, it is a
+// MutableIterator when the collection of the values has one.
+func (v *multiMapValues[K, V, C]) Iterator() Iterator[V] {
+	values, ok := v.values()
+	if !ok {
+		return &emptyListIterator[V]{}
+	}
+	return &multiMapIterator[K, V, C]{view: v, values: values, it: values.Iterator()}
+}
+
+// All returns a sequence over the values of the key.
+func (v *multiMapValues[K, V, C]) All() iter.Seq[V] {
+	return Seq(v.Iterator())
+}
+
+func (v *multiMapValues[K, V, C]) Empty() bool {
+	return v.Size() == 0
+}
+
+func (v *multiMapValues[K, V, C]) Size() int {
+	values, ok := v.values()
+	if !ok {
+		return 0
+	}
+	return values.Size()
+}
+
+func (v *multiMapValues[K, V, C]) Push(item V) {
+	v.m.Put(v.key, item)
+}
+
+func (v *multiMapValues[K, V, C]) Contains(item V) bool {
+	return v.m.ContainsEntry(v.key, item)
+}
+
+// Delete removes item from the values of the key, but if item is not there
+// well this method return ErrItemNotFound error.
+func (v *multiMapValues[K, V, C]) Delete(item V) error {
+	if !v.m.Remove(v.key, item) {
+		return ErrItemNotFound{item}
+	}
+	return nil
+}
+
+func (v *multiMapValues[K, V, C]) PushAll(items Iterable[V]) {
+	v.m.PutAll(v.key, items)
+}
+
+func (v *multiMapValues[K, V, C]) ContainsAll(items Iterable[V]) bool {
+	values, ok := v.values()
+	if !ok {
+		return !items.Iterator().HasNext()
+	}
+	return values.ContainsAll(v.own(items))
+}
+
+func (v *multiMapValues[K, V, C]) DeleteAll(items Iterable[V]) int {
+	items = v.own(items)
+	removed := 0
+	v.m.update(v.key, func(values C) {
+		removed = values.DeleteAll(items)
+	})
+	return removed
+}
+
+func (v *multiMapValues[K, V, C]) RetainAll(items Iterable[V]) int {
+	items = v.own(items)
+	removed := 0
+	v.m.update(v.key, func(values C) {
+		removed = values.RetainAll(items)
+	})
+	return removed
+}
+
+func (v *multiMapValues[K, V, C]) RemoveIf(pred func(item V) bool) int {
+	removed := 0
+	v.m.update(v.key, func(values C) {
+		removed = values.RemoveIf(pred)
+	})
+	return removed
+}
+
+// Clear removes the key from the map.
+func (v *multiMapValues[K, V, C]) Clear() {
+	v.m.RemoveAll(v.key)
+}
+
+func (v *multiMapValues[K, V, C]) ToSlice() []V {
+	values, ok := v.values()
+	if !ok {
+		return []V{}
+	}
+	return values.ToSlice()
+}
+
+func (v *multiMapValues[K, V, C]) String() string {
+	values, ok := v.values()
+	if !ok {
+		return "[]"
+	}
+	return fmt.Sprintf("%v", values)
+}
+
+// multiMapIterator reports the removals made through the iterator of the
+// values to the map.
+type multiMapIterator[K comparable, V any, C Collection[V]] struct {
+	view   *multiMapValues[K, V, C]
+	values C
+	it     Iterator[V]
+}
+
+func (it *multiMapIterator[K, V, C]) HasNext() bool {
+	return it.it.HasNext()
+}
+
+func (it *multiMapIterator[K, V, C]) Next() V {
+	return it.it.Next()
+}
+
+func (it *multiMapIterator[K, V, C]) NextWithIndex() (int, V) {
+	return it.it.NextWithIndex()
+}
+
+func (it *multiMapIterator[K, V, C]) Remove() error {
+	mit, ok := it.it.(MutableIterator[V])
+	if !ok {
+		return ErrUnsupportedOperation
+	}
+	// the key left the map since the iterator was created
+	if values, ok := it.view.values(); !ok || any(values) != any(it.values) {
+		return ErrIllegalIteratorState
+	}
+	var err error
+	it.view.m.update(it.view.key, func(values C) {
+		err = mit.Remove()
+	})
+	return err
+}
@@ -0,0 +1,178 @@
+package collection
+
+import (
+	"slices"
+	"testing"
+)
+
+var multiMaps = []struct {
+	name string
+	new  func() MultiMap[string, int]
+}{
+	{name: "list multimap", new: func() MultiMap[string, int] { return NewListMultiMap[string, int]() }},
+	{name: "set multimap", new: func() MultiMap[string, int] { return NewSetMultiMap[string, int]() }},
+}
+
+// multiMapContent returns the values of key sorted.
+func multiMapContent(m MultiMap[string, int], key string) []int {
+	values := m.Get(key).ToSlice()
+	slices.Sort(values)
+	return values
+}
+
+func TestMultiMap_PutRemove(t *testing.T) {
+	useCases := []struct {
+		description string
+		change      func(m MultiMap[string, int]) bool
+		want        bool
+		wantSize    int
+		wantA       []int
+	}{
+		{description: "put a new key", change: func(m MultiMap[string, int]) bool { return m.Put("c", 1) }, want: true, wantSize: 4, wantA: []int{1, 2}},
+		{description: "put a new value", change: func(m MultiMap[string, int]) bool { return m.Put("a", 3) }, want: true, wantSize: 4, wantA: []int{1, 2, 3}},
+		{description: "put all", change: func(m MultiMap[string, int]) bool { return m.PutAll("a", NewSlice(3, 4)) }, want: true, wantSize: 5, wantA: []int{1, 2, 3, 4}},
+		{description: "put all of nothing", change: func(m MultiMap[string, int]) bool { return m.PutAll("c", NewSlice[int]()) }, want: false, wantSize: 3, wantA: []int{1, 2}},
+		{description: "remove a pair", change: func(m MultiMap[string, int]) bool { return m.Remove("a", 1) }, want: true, wantSize: 2, wantA: []int{2}},
+		{description: "remove a missing pair", change: func(m MultiMap[string, int]) bool { return m.Remove("a", 9) }, want: false, wantSize: 3, wantA: []int{1, 2}},
+		{description: "remove a pair of a missing key", change: func(m MultiMap[string, int]) bool { return m.Remove("c", 1) }, want: false, wantSize: 3, wantA: []int{1, 2}},
+		{description: "remove all", change: func(m MultiMap[string, int]) bool { return m.RemoveAll("a").Size() == 2 }, want: true, wantSize: 1, wantA: []int{}},
+		{description: "remove all of a missing key", change: func(m MultiMap[string, int]) bool { return m.RemoveAll("c").Empty() }, want: true, wantSize: 3, wantA: []int{1, 2}},
+		{description: "clear", change: func(m MultiMap[string, int]) bool { m.Clear(); return m.Empty() }, want: true, wantSize: 0, wantA: []int{}},
+	}
+
+	for _, f := range multiMaps {
+		for _, tt := range useCases {
+			m := f.new()
+			m.PutAll("a", NewSlice(1, 2))
+			m.Put("b", 1)
+			result := tt.change(m)
+			if values := multiMapContent(m, "a"); result != tt.want || m.Size() != tt.wantSize || !slices.Equal(values, tt.wantA) {
+				t.Errorf("test: %s %s want (%v, %v, %v) got (%v, %v, %v)", f.name, tt.description, tt.want, tt.wantSize, tt.wantA, result, m.Size(), values)
+			}
+		}
+	}
+}
+
+func TestMultiMap_Contains(t *testing.T) {
+	for _, f := range multiMaps {
+		m := f.new()
+		m.Put("a", 1)
+		m.Put("b", 2)
+		m.Remove("b", 2)
+		if !m.ContainsEntry("a", 1) || m.ContainsEntry("a", 2) || m.ContainsEntry("c", 1) {
+			t.Errorf("test: %s contains entry want only [a, 1] got %v", f.name, m)
+		}
+		if !m.ContainsKey("a") || m.ContainsKey("b") {
+			t.Errorf("test: %s a key without values want dropped got %v", f.name, m.KeySet())
+		}
+		if !m.ContainsValue(1) || m.ContainsValue(2) {
+			t.Errorf("test: %s contains value want only 1 got %v", f.name, m)
+		}
+	}
+}
+
+func TestMultiMap_KeySetEntries(t *testing.T) {
+	for _, f := range multiMaps {
+		m := f.new()
+		m.PutAll("a", NewSlice(1, 2))
+		m.Put("b", 3)
+		if keys := m.KeySet(); keys.Size() != 2 || !keys.Contains("a") || !keys.Contains("b") {
+			t.Errorf("test: %s key set want [a b] got %v", f.name, keys)
+		}
+		var result []string
+		for it := m.Entries().Iterator(); it.HasNext(); {
+			result = append(result, it.Next().String())
+		}
+		slices.Sort(result)
+		want := []string{"[[a, 1]]", "[[a, 2]]", "[[b, 3]]"}
+		if !slices.Equal(result, want) {
+			t.Errorf("test: %s entries want %v got %v", f.name, want, result)
+		}
+	}
+}
+
+func TestMultiMap_GetView(t *testing.T) {
+	useCases := []struct {
+		description string
+		change      func(view Collection[int]) int
+		want        int
+		wantSize    int
+		wantA       []int
+	}{
+		{description: "push", change: func(view Collection[int]) int { view.Push(4); return view.Size() }, want: 4, wantSize: 5, wantA: []int{1, 2, 3, 4}},
+		{description: "delete", change: func(view Collection[int]) int { view.Delete(2); return view.Size() }, want: 2, wantSize: 3, wantA: []int{1, 3}},
+		{description: "delete all", change: func(view Collection[int]) int { return view.DeleteAll(NewSlice(1, 3, 9)) }, want: 2, wantSize: 2, wantA: []int{2}},
+		{description: "delete all of itself", change: func(view Collection[int]) int { return view.DeleteAll(view) }, want: 3, wantSize: 1, wantA: []int{}},
+		{description: "retain all", change: func(view Collection[int]) int { return view.RetainAll(NewHashSet(1, 9)) }, want: 2, wantSize: 2, wantA: []int{1}},
+		{description: "retain all of itself", change: func(view Collection[int]) int { return view.RetainAll(view) }, want: 0, wantSize: 4, wantA: []int{1, 2, 3}},
+		{description: "remove if", change: func(view Collection[int]) int { return view.RemoveIf(func(x int) bool { return x > 1 }) }, want: 2, wantSize: 2, wantA: []int{1}},
+		{description: "push all of itself", change: func(view Collection[int]) int { view.PushAll(view); return view.Size() }, want: -1, wantSize: -1, wantA: nil},
+		{description: "clear", change: func(view Collection[int]) int { view.Clear(); return view.Size() }, want: 0, wantSize: 1, wantA: []int{}},
+		{description: "iterator remove", change: func(view Collection[int]) int {
+			removed := 0
+			for it := view.Iterator(); it.HasNext(); {
+				if it.Next() != 2 {
+					it.(MutableIterator[int]).Remove()
+					removed++
+				}
+			}
+			return removed
+		}, want: 2, wantSize: 2, wantA: []int{2}},
+	}
+
+	for _, f := range multiMaps {
+		for _, tt := range useCases {
+			m := f.new()
+			m.PutAll("a", NewSlice(1, 2, 3))
+			m.Put("b", 1)
+			view := m.Get("a")
+			result := tt.change(view)
+			if tt.wantA == nil {
+				// a list doubles its values, a set keeps them
+				if view.Size() != 6 && view.Size() != 3 || m.Size() != view.Size()+1 {
+					t.Errorf("test: %s %s want 6 or 3 values got %v", f.name, tt.description, view)
+				}
+				continue
+			}
+			if values := multiMapContent(m, "a"); result != tt.want || m.Size() != tt.wantSize || !slices.Equal(values, tt.wantA) {
+				t.Errorf("test: %s %s want (%v, %v, %v) got (%v, %v, %v)", f.name, tt.description, tt.want, tt.wantSize, tt.wantA, result, m.Size(), values)
+			}
+			if m.ContainsKey("a") == view.Empty() {
+				t.Errorf("test: %s %s want the key only while it has values got %v", f.name, tt.description, m.KeySet())
+			}
+		}
+	}
+}
+
+func TestMultiMap_GetMissingKey(t *testing.T) {
+	for _, f := range multiMaps {
+		m := f.new()
+		view := m.Get("a")
+		if !view.Empty() || view.Contains(1) || view.Delete(1) == nil || !view.ContainsAll(NewSlice[int]()) || view.Iterator().HasNext() {
+			t.Errorf("test: %s view of a missing key want empty got %v", f.name, view)
+		}
+		m.Put("a", 1)
+		if view.Size() != 1 || !view.Contains(1) {
+			t.Errorf("test: %s view want to follow the map got %v", f.name, view)
+		}
+		m.RemoveAll("a")
+		view.Push(2)
+		if !m.ContainsEntry("a", 2) || m.Size() != 1 {
+			t.Errorf("test: %s push on the view want to add the key got %v", f.name, m)
+		}
+	}
+}
+
+func TestMultiMap_StaleIterator(t *testing.T) {
+	for _, f := range multiMaps {
+		m := f.new()
+		m.Put("a", 1)
+		it := m.Get("a").Iterator()
+		it.Next()
+		m.RemoveAll("a")
+		m.Put("a", 2)
+		if err := it.(MutableIterator[int]).Remove(); err != ErrIllegalIteratorState || m.Size() != 1 {
+			t.Errorf("test: %s remove through a stale iterator want %v got %v", f.name, ErrIllegalIteratorState, err)
+		}
+	}
+}
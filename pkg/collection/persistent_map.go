@@ -0,0 +1,348 @@
+package collection
+
+import (
+	"fmt"
+	"hash/maphash"
+	"iter"
+	"math/bits"
+	"strings"
+)
+
+const (
+	hamtBits = 5
+	hamtMask = 1<<hamtBits - 1
+	// hamtMaxShift is the shift past which the hash has no bits left and
+	// colliding keys are kept in a plain list.
+	hamtMaxShift = 64
+)
+
+var hamtSeed = maphash.MakeSeed()
+
+// hamtSlot is either an entry or, when child is not nil, a subtree.
+type hamtSlot[K comparable, V any] struct {
+	hash  uint64
+	key   K
+	value V
+	child *hamtNode[K, V]
+}
+
+// hamtNode is a node of a hash array mapped trie. Each level consumes
+// hamtBits bits of the hash to choose one of 32 slots, only the used slots
+// are stored in order and bitmap tells which ones they are. Past
+// hamtMaxShift the node is a list of entries with the same hash and bitmap
+// is unused.
+type hamtNode[K comparable, V any] struct {
+	bitmap uint32
+	slots  []hamtSlot[K, V]
+}
+
+func hamtBit(hash uint64, shift int) uint32 {
+	return 1 << ((hash >> shift) & hamtMask)
+}
+
+// index returns the position in slots of the slot for bit.
+func (n *hamtNode[K, V]) index(bit uint32) int {
+	return bits.OnesCount32(n.bitmap & (bit - 1))
+}
+
+func (n *hamtNode[K, V]) get(key K, hash uint64, shift int) (V, bool) {
+	for {
+		if shift >= hamtMaxShift {
+			for _, s := range n.slots {
+				if s.key == key {
+					return s.value, true
+				}
+			}
+			return *new(V), false
+		}
+		bit := hamtBit(hash, shift)
+		if n.bitmap&bit == 0 {
+			return *new(V), false
+		}
+		s := n.slots[n.index(bit)]
+		if s.child == nil {
+			if s.hash == hash && s.key == key {
+				return s.value, true
+			}
+			return *new(V), false
+		}
+		n, shift = s.child, shift+hamtBits
+	}
+}
+
+// withSlot returns a copy of n where the slot at i is replaced by s.
+func (n *hamtNode[K, V]) withSlot(i int, s hamtSlot[K, V]) *hamtNode[K, V] {
+	slots := append([]hamtSlot[K, V](nil), n.slots...)
+	slots[i] = s
+	return &hamtNode[K, V]{bitmap: n.bitmap, slots: slots}
+}
+
+// assoc returns a copy of n where key is associated to value and whether the key is new.
+func (n *hamtNode[K, V]) assoc(leaf hamtSlot[K, V], shift int) (*hamtNode[K, V], bool) {
+	if shift >= hamtMaxShift {
+		for i, s := range n.slots {
+			if s.key == leaf.key {
+				return n.withSlot(i, leaf), false
+			}
+		}
+		return &hamtNode[K, V]{slots: append(append([]hamtSlot[K, V](nil), n.slots...), leaf)}, true
+	}
+
+	bit := hamtBit(leaf.hash, shift)
+	i := n.index(bit)
+	if n.bitmap&bit == 0 {
+		slots := make([]hamtSlot[K, V], 0, len(n.slots)+1)
+		slots = append(slots, n.slots[:i]...)
+		slots = append(slots, leaf)
+		slots = append(slots, n.slots[i:]...)
+		return &hamtNode[K, V]{bitmap: n.bitmap | bit, slots: slots}, true
+	}
+
+	s := n.slots[i]
+	switch {
+	case s.child != nil:
+		child, added := s.child.assoc(leaf, shift+hamtBits)
+		return n.withSlot(i, hamtSlot[K, V]{child: child}), added
+	case s.hash == leaf.hash && s.key == leaf.key:
+		return n.withSlot(i, leaf), false
+	default:
+		return n.withSlot(i, hamtSlot[K, V]{child: hamtPair(s, leaf, shift+hamtBits)}), true
+	}
+}
+
+// hamtPair builds the subtree holding the two entries a and b from the given shift.
+func hamtPair[K comparable, V any](a, b hamtSlot[K, V], shift int) *hamtNode[K, V] {
+	if shift >= hamtMaxShift {
+		return &hamtNode[K, V]{slots: []hamtSlot[K, V]{a, b}}
+	}
+	bitA, bitB := hamtBit(a.hash, shift), hamtBit(b.hash, shift)
+	if bitA == bitB {
+		return &hamtNode[K, V]{bitmap: bitA, slots: []hamtSlot[K, V]{{child: hamtPair(a, b, shift+hamtBits)}}}
+	}
+	if bitA > bitB {
+		a, b = b, a
+	}
+	return &hamtNode[K, V]{bitmap: bitA | bitB, slots: []hamtSlot[K, V]{a, b}}
+}
+
+// dissoc returns a copy of n without key, or nil when nothing is left, and
+// whether the key was there. A subtree left with a single entry is replaced
+// by the entry itself so that every map has a single shape.
+func (n *hamtNode[K, V]) dissoc(key K, hash uint64, shift int) (*hamtNode[K, V], bool) {
+	var i int
+	var bit uint32
+	if shift >= hamtMaxShift {
+		i = -1
+		for j, s := range n.slots {
+			if s.key == key {
+				i = j
+			}
+		}
+		if i < 0 {
+			return n, false
+		}
+	} else {
+		bit = hamtBit(hash, shift)
+		if n.bitmap&bit == 0 {
+			return n, false
+		}
+		i = n.index(bit)
+		s := n.slots[i]
+		if s.child != nil {
+			child, removed := s.child.dissoc(key, hash, shift+hamtBits)
+			switch {
+			case !removed:
+				return n, false
+			case len(child.slots) == 1 && child.slots[0].child == nil:
+				return n.withSlot(i, child.slots[0]), true
+			default:
+				return n.withSlot(i, hamtSlot[K, V]{child: child}), true
+			}
+		}
+		if s.hash != hash || s.key != key {
+			return n, false
+		}
+	}
+
+	if len(n.slots) == 1 {
+		return nil, true
+	}
+	slots := make([]hamtSlot[K, V], 0, len(n.slots)-1)
+	slots = append(slots, n.slots[:i]...)
+	slots = append(slots, n.slots[i+1:]...)
+	return &hamtNode[K, V]{bitmap: n.bitmap &^ bit, slots: slots}, true
+}
+
+func (n *hamtNode[K, V]) all(yield func(K, V) bool) bool {
+	for _, s := range n.slots {
+		if s.child != nil {
+			if !s.child.all(yield) {
+				return false
+			}
+		} else if !yield(s.key, s.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// PersistentMap is an immutable hash map, Assoc and Dissoc return a new map
+// sharing most of its nodes with the old one which stays valid. It is a
+// hash array mapped trie so every operation takes O(log32 n). A
+// PersistentMap is safe for concurrent use.
+type PersistentMap[K comparable, V any] struct {
+	root *hamtNode[K, V]
+	size int
+}
+
+func NewPersistentMap[K comparable, V any](entries ...*Entry[K, V]) *PersistentMap[K, V] {
+	m := &PersistentMap[K, V]{}
+	for _, e := range entries {
+		m = m.Assoc(e.key, e.value)
+	}
+	return m
+}
+
+// NewPersistentMapFrom creates a PersistentMap with the entries of m, such as a HashMap.
+func NewPersistentMapFrom[K comparable, V any](m Map[K, V]) *PersistentMap[K, V] {
+	p := &PersistentMap[K, V]{}
+	for it := m.EntryList().Iterator(); it.HasNext(); {
+		e := it.Next()
+		p = p.Assoc(e.key, e.value)
+	}
+	return p
+}
+
+// ToHashMap returns a new HashMap with the entries of the map.
+func (m *PersistentMap[K, V]) ToHashMap() *HashMap[K, V] {
+	return CollectMap(m.All())
+}
+
+func (m *PersistentMap[K, V]) Empty() bool {
+	return m.Size() == 0
+}
+
+func (m *PersistentMap[K, V]) Size() int {
+	return m.size
+}
+
+func (m *PersistentMap[K, V]) Get(key K) (V, bool) {
+	if m.root == nil {
+		return *new(V), false
+	}
+	return m.root.get(key, maphash.Comparable(hamtSeed, key), 0)
+}
+
+func (m *PersistentMap[K, V]) ContainsKey(key K) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+// Assoc returns a new map where key is associated to value.
+func (m *PersistentMap[K, V]) Assoc(key K, value V) *PersistentMap[K, V] {
+	leaf := hamtSlot[K, V]{hash: maphash.Comparable(hamtSeed, key), key: key, value: value}
+	if m.root == nil {
+		return &PersistentMap[K, V]{root: &hamtNode[K, V]{bitmap: hamtBit(leaf.hash, 0), slots: []hamtSlot[K, V]{leaf}}, size: 1}
+	}
+	root, added := m.root.assoc(leaf, 0)
+	size := m.size
+	if added {
+		size++
+	}
+	return &PersistentMap[K, V]{root: root, size: size}
+}
+
+// Dissoc returns a new map without key, or the map itself when key is not in it.
+func (m *PersistentMap[K, V]) Dissoc(key K) *PersistentMap[K, V] {
+	if m.root == nil {
+		return m
+	}
+	root, removed := m.root.dissoc(key, maphash.Comparable(hamtSeed, key), 0)
+	if !removed {
+		return m
+	}
+	return &PersistentMap[K, V]{root: root, size: m.size - 1}
+}
+
+// Iterator returns an iterator over the entries of the map in random order.
+func (m *PersistentMap[K, V]) Iterator() Iterator[*Entry[K, V]] {
+	if m.Empty() {
+		return &emptyListIterator[*Entry[K, V]]{}
+	}
+	it := &hamtIterator[K, V]{stack: []hamtFrame[K, V]{{node: m.root}}}
+	it.advance()
+	return it
+}
+
+// All returns a sequence over the pairs of the map in random order.
+func (m *PersistentMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if m.root != nil {
+			m.root.all(yield)
+		}
+	}
+}
+
+func (m *PersistentMap[K, V]) String() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	i := 0
+	for k, v := range m.All() {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%v", NewEntry(k, v)))
+		i++
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+type hamtFrame[K comparable, V any] struct {
+	node  *hamtNode[K, V]
+	index int
+}
+
+// hamtIterator walks the trie depth first keeping the path to the next entry.
+type hamtIterator[K comparable, V any] struct {
+	stack []hamtFrame[K, V]
+	next  *hamtSlot[K, V]
+	index int
+}
+
+// advance moves next to the following entry, or to nil at the end of the trie.
+func (it *hamtIterator[K, V]) advance() {
+	it.next = nil
+	for len(it.stack) > 0 {
+		top := &it.stack[len(it.stack)-1]
+		if top.index == len(top.node.slots) {
+			it.stack = it.stack[:len(it.stack)-1]
+			continue
+		}
+		s := &top.node.slots[top.index]
+		top.index++
+		if s.child != nil {
+			it.stack = append(it.stack, hamtFrame[K, V]{node: s.child})
+			continue
+		}
+		it.next = s
+		return
+	}
+}
+
+func (it *hamtIterator[K, V]) HasNext() bool {
+	return it.next != nil
+}
+
+func (it *hamtIterator[K, V]) Next() *Entry[K, V] {
+	_, e := it.NextWithIndex()
+	return e
+}
+
+func (it *hamtIterator[K, V]) NextWithIndex() (int, *Entry[K, V]) {
+	e := NewEntry(it.next.key, it.next.value)
+	index := it.index
+	it.index++
+	it.advance()
+	return index, e
+}
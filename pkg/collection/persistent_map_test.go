@@ -0,0 +1,237 @@
+package collection
+
+import (
+	"math/rand"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func pmContent[K comparable, V any](m *PersistentMap[K, V]) map[K]V {
+	content := make(map[K]V)
+	for it := m.Iterator(); it.HasNext(); {
+		e := it.Next()
+		content[e.key] = e.value
+	}
+	return content
+}
+
+func TestPersistentMap_Get(t *testing.T) {
+	big := NewPersistentMap[int, int]()
+	for i := range 5000 {
+		big = big.Assoc(i, i*2)
+	}
+
+	useCases := []struct {
+		description string
+		m           *PersistentMap[int, int]
+		key         int
+		want        int
+		ok          bool
+	}{
+		{description: "empty map", m: NewPersistentMap[int, int](), key: 1},
+		{description: "present key", m: NewPersistentMap(NewEntry(1, 10), NewEntry(2, 20)), key: 2, want: 20, ok: true},
+		{description: "missing key", m: NewPersistentMap(NewEntry(1, 10)), key: 3},
+		{description: "deep trie", m: big, key: 4321, want: 8642, ok: true},
+		{description: "missing key in deep trie", m: big, key: 5000},
+	}
+
+	for _, tt := range useCases {
+		result, ok := tt.m.Get(tt.key)
+		if result != tt.want || ok != tt.ok {
+			t.Errorf("test: %s want (%v, %v) got (%v, %v)", tt.description, tt.want, tt.ok, result, ok)
+		}
+		if tt.m.ContainsKey(tt.key) != tt.ok {
+			t.Errorf("test: %s ContainsKey want %v got %v", tt.description, tt.ok, !tt.ok)
+		}
+	}
+}
+
+func TestPersistentMap_Assoc(t *testing.T) {
+	useCases := []struct {
+		description string
+		m           *PersistentMap[string, int]
+		key         string
+		value       int
+		want        map[string]int
+	}{
+		{description: "assoc to empty map", m: NewPersistentMap[string, int](), key: "a", value: 1, want: map[string]int{"a": 1}},
+		{description: "assoc new key", m: NewPersistentMap(NewEntry("a", 1)), key: "b", value: 2, want: map[string]int{"a": 1, "b": 2}},
+		{description: "replace value", m: NewPersistentMap(NewEntry("a", 1), NewEntry("b", 2)), key: "a", value: 3, want: map[string]int{"a": 3, "b": 2}},
+	}
+
+	for _, tt := range useCases {
+		before := pmContent(tt.m)
+		result := tt.m.Assoc(tt.key, tt.value)
+		if content := pmContent(result); !reflect.DeepEqual(content, tt.want) || result.Size() != len(tt.want) {
+			t.Errorf("test: %s want %v got %v size %d", tt.description, tt.want, content, result.Size())
+		}
+		if content := pmContent(tt.m); !reflect.DeepEqual(content, before) {
+			t.Errorf("test: %s original modified want %v got %v", tt.description, before, content)
+		}
+	}
+}
+
+func TestPersistentMap_Dissoc(t *testing.T) {
+	useCases := []struct {
+		description string
+		m           *PersistentMap[string, int]
+		key         string
+		want        map[string]int
+	}{
+		{description: "dissoc from empty map", m: NewPersistentMap[string, int](), key: "a", want: map[string]int{}},
+		{description: "dissoc missing key", m: NewPersistentMap(NewEntry("a", 1)), key: "b", want: map[string]int{"a": 1}},
+		{description: "dissoc last key", m: NewPersistentMap(NewEntry("a", 1)), key: "a", want: map[string]int{}},
+		{description: "dissoc a key", m: NewPersistentMap(NewEntry("a", 1), NewEntry("b", 2)), key: "a", want: map[string]int{"b": 2}},
+	}
+
+	for _, tt := range useCases {
+		before := pmContent(tt.m)
+		result := tt.m.Dissoc(tt.key)
+		if content := pmContent(result); !reflect.DeepEqual(content, tt.want) || result.Size() != len(tt.want) {
+			t.Errorf("test: %s want %v got %v size %d", tt.description, tt.want, content, result.Size())
+		}
+		if content := pmContent(tt.m); !reflect.DeepEqual(content, before) {
+			t.Errorf("test: %s original modified want %v got %v", tt.description, before, content)
+		}
+	}
+}
+
+func TestPersistentMap_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	m := NewPersistentMap[int, int]()
+	model := make(map[int]int)
+	versions := []*PersistentMap[int, int]{m}
+	models := []map[int]int{{}}
+
+	for i := range 20000 {
+		key := r.Intn(3000)
+		if r.Intn(3) == 0 {
+			m = m.Dissoc(key)
+			delete(model, key)
+		} else {
+			m = m.Assoc(key, i)
+			model[key] = i
+		}
+		if i%2000 == 0 {
+			snapshot := make(map[int]int, len(model))
+			for k, v := range model {
+				snapshot[k] = v
+			}
+			versions = append(versions, m)
+			models = append(models, snapshot)
+		}
+	}
+
+	versions = append(versions, m)
+	models = append(models, model)
+	for i, v := range versions {
+		if content := pmContent(v); !reflect.DeepEqual(content, models[i]) || v.Size() != len(models[i]) {
+			t.Errorf("test: version %d want %d entries got %d size %d", i, len(models[i]), len(content), v.Size())
+		}
+	}
+
+	for key := range model {
+		m = m.Dissoc(key)
+	}
+	if !m.Empty() || m.root != nil {
+		t.Errorf("test: dissoc every key want empty map got %v", m)
+	}
+}
+
+func TestPersistentMap_Collisions(t *testing.T) {
+	slot := func(key string, hash uint64) hamtSlot[string, int] {
+		return hamtSlot[string, int]{hash: hash, key: key, value: len(key)}
+	}
+	const hash = 0xdeadbeef
+	root := &hamtNode[string, int]{bitmap: hamtBit(hash, 0), slots: []hamtSlot[string, int]{slot("a", hash)}}
+	root, _ = root.assoc(slot("bb", hash), 0)
+	root, added := root.assoc(slot("ccc", hash), 0)
+	if !added {
+		t.Errorf("test: assoc colliding key want added")
+	}
+	root, added = root.assoc(slot("bb", hash), 0)
+	if added {
+		t.Errorf("test: assoc existing colliding key want replaced")
+	}
+
+	for _, key := range []string{"a", "bb", "ccc"} {
+		if v, ok := root.get(key, hash, 0); !ok || v != len(key) {
+			t.Errorf("test: get colliding key %s want (%d, true) got (%d, %v)", key, len(key), v, ok)
+		}
+	}
+	if _, ok := root.get("d", hash, 0); ok {
+		t.Errorf("test: get missing colliding key want false")
+	}
+
+	root, _ = root.dissoc("a", hash, 0)
+	root, _ = root.dissoc("ccc", hash, 0)
+	if len(root.slots) != 1 || root.slots[0].child != nil || root.slots[0].key != "bb" {
+		t.Errorf("test: dissoc colliding keys want a single entry at the root got %v", root.slots)
+	}
+}
+
+func TestPersistentMap_Concurrent(t *testing.T) {
+	base := NewPersistentMap[int, int]()
+	for i := range stressOperations {
+		base = base.Assoc(i, i)
+	}
+
+	var wg sync.WaitGroup
+	for g := range stressGoroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m := base
+			for i := range stressOperations {
+				m = m.Assoc(i, g).Dissoc(i + 1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i := range stressOperations {
+		if v, _ := base.Get(i); v != i {
+			t.Errorf("test: shared map modified want %d got %d", i, v)
+		}
+	}
+}
+
+func TestPersistentMap_HashMap(t *testing.T) {
+	h := NewHashMap(NewEntry("a", 1), NewEntry("b", 2))
+	m := NewPersistentMapFrom[string, int](h)
+	if content := pmContent(m); !reflect.DeepEqual(content, h.table) {
+		t.Errorf("test: from HashMap want %v got %v", h.table, content)
+	}
+	back := m.Assoc("c", 3).ToHashMap()
+	want := map[string]int{"a": 1, "b": 2, "c": 3}
+	if !reflect.DeepEqual(back.table, want) {
+		t.Errorf("test: to HashMap want %v got %v", want, back.table)
+	}
+}
+
+func TestPersistentMap_All(t *testing.T) {
+	m := NewPersistentMap(NewEntry(1, 1), NewEntry(2, 2), NewEntry(3, 3))
+	sum := 0
+	for k, v := range m.All() {
+		sum += k + v
+	}
+	if sum != 12 {
+		t.Errorf("test: All want %d got %d", 12, sum)
+	}
+	for range m.All() {
+		break
+	}
+	if s := NewPersistentMap(NewEntry("a", 1)).String(); s != "{[[a, 1]]}" {
+		t.Errorf("test: String want %s got %s", "{[[a, 1]]}", s)
+	}
+}
+
+func BenchmarkPersistentMap_Assoc(b *testing.B) {
+	for b.Loop() {
+		m := NewPersistentMap[int, int]()
+		for i := range 1000 {
+			m = m.Assoc(i, i)
+		}
+	}
+}
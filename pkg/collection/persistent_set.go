@@ -0,0 +1,118 @@
+package collection
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// PersistentSet is an immutable hash set built on PersistentMap, Assoc and
+// Dissoc return a new set sharing most of its nodes with the old one which
+// stays valid. A PersistentSet is safe for concurrent use.
+type PersistentSet[E comparable] struct {
+	m *PersistentMap[E, struct{}]
+}
+
+func NewPersistentSet[E comparable](items ...E) *PersistentSet[E] {
+	m := &PersistentMap[E, struct{}]{}
+	for _, item := range items {
+		m = m.Assoc(item, struct{}{})
+	}
+	return &PersistentSet[E]{m: m}
+}
+
+// NewPersistentSetFrom creates a PersistentSet with the items of s, such as a HashSet.
+func NewPersistentSetFrom[E comparable](s Set[E]) *PersistentSet[E] {
+	m := &PersistentMap[E, struct{}]{}
+	for it := s.Iterator(); it.HasNext(); {
+		m = m.Assoc(it.Next(), struct{}{})
+	}
+	return &PersistentSet[E]{m: m}
+}
+
+// ToHashSet returns a new HashSet with the items of the set.
+func (s *PersistentSet[E]) ToHashSet() *HashSet[E] {
+	h := NewHashSet[E]()
+	for item := range s.All() {
+		h.Push(item)
+	}
+	return h
+}
+
+func (s *PersistentSet[E]) Empty() bool {
+	return s.Size() == 0
+}
+
+func (s *PersistentSet[E]) Size() int {
+	return s.m.Size()
+}
+
+func (s *PersistentSet[E]) Contains(item E) bool {
+	return s.m.ContainsKey(item)
+}
+
+// Assoc returns a new set with item added.
+func (s *PersistentSet[E]) Assoc(item E) *PersistentSet[E] {
+	return &PersistentSet[E]{m: s.m.Assoc(item, struct{}{})}
+}
+
+// Dissoc returns a new set without item, or the set itself when item is not in it.
+func (s *PersistentSet[E]) Dissoc(item E) *PersistentSet[E] {
+	m := s.m.Dissoc(item)
+	if m == s.m {
+		return s
+	}
+	return &PersistentSet[E]{m: m}
+}
+
+// Iterator returns an iterator over the items of the set in random order.
+func (s *PersistentSet[E]) Iterator() Iterator[E] {
+	if s.Empty() {
+		return &emptyListIterator[E]{}
+	}
+	it := &hamtIterator[E, struct{}]{stack: []hamtFrame[E, struct{}]{{node: s.m.root}}}
+	it.advance()
+	return &persistentSetIterator[E]{it}
+}
+
+// All returns a sequence over the items of the set in random order.
+func (s *PersistentSet[E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for item := range s.m.All() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+func (s *PersistentSet[E]) String() string {
+	var sb strings.Builder
+	sb.WriteString("[")
+	i := 0
+	for item := range s.All() {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%v", item))
+		i++
+	}
+	sb.WriteString("]")
+	return sb.String()
+}
+
+type persistentSetIterator[E comparable] struct {
+	*hamtIterator[E, struct{}]
+}
+
+func (it *persistentSetIterator[E]) Next() E {
+	_, item := it.NextWithIndex()
+	return item
+}
+
+func (it *persistentSetIterator[E]) NextWithIndex() (int, E) {
+	index, item := it.index, it.next.key
+	it.index++
+	it.advance()
+	return index, item
+}
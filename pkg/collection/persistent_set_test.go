@@ -0,0 +1,80 @@
+package collection
+
+import (
+	"reflect"
+	"testing"
+)
+
+func psContent[E comparable](s *PersistentSet[E]) map[E]bool {
+	content := make(map[E]bool)
+	for it := s.Iterator(); it.HasNext(); {
+		content[it.Next()] = true
+	}
+	return content
+}
+
+func TestPersistentSet_Assoc(t *testing.T) {
+	useCases := []struct {
+		description string
+		set         *PersistentSet[int]
+		item        int
+		want        map[int]bool
+	}{
+		{description: "assoc to empty set", set: NewPersistentSet[int](), item: 1, want: map[int]bool{1: true}},
+		{description: "assoc new item", set: NewPersistentSet(1, 2), item: 3, want: map[int]bool{1: true, 2: true, 3: true}},
+		{description: "assoc existing item", set: NewPersistentSet(1, 2), item: 2, want: map[int]bool{1: true, 2: true}},
+	}
+
+	for _, tt := range useCases {
+		before := psContent(tt.set)
+		result := tt.set.Assoc(tt.item)
+		if content := psContent(result); !reflect.DeepEqual(content, tt.want) || result.Size() != len(tt.want) {
+			t.Errorf("test: %s want %v got %v size %d", tt.description, tt.want, content, result.Size())
+		}
+		if content := psContent(tt.set); !reflect.DeepEqual(content, before) {
+			t.Errorf("test: %s original modified want %v got %v", tt.description, before, content)
+		}
+	}
+}
+
+func TestPersistentSet_Dissoc(t *testing.T) {
+	useCases := []struct {
+		description string
+		set         *PersistentSet[int]
+		item        int
+		want        map[int]bool
+		same        bool
+	}{
+		{description: "dissoc from empty set", set: NewPersistentSet[int](), item: 1, want: map[int]bool{}, same: true},
+		{description: "dissoc missing item", set: NewPersistentSet(1, 2), item: 3, want: map[int]bool{1: true, 2: true}, same: true},
+		{description: "dissoc item", set: NewPersistentSet(1, 2), item: 2, want: map[int]bool{1: true}},
+	}
+
+	for _, tt := range useCases {
+		result := tt.set.Dissoc(tt.item)
+		if content := psContent(result); !reflect.DeepEqual(content, tt.want) || result.Size() != len(tt.want) {
+			t.Errorf("test: %s want %v got %v size %d", tt.description, tt.want, content, result.Size())
+		}
+		if (result == tt.set) != tt.same {
+			t.Errorf("test: %s same set want %v got %v", tt.description, tt.same, !tt.same)
+		}
+		if result.Contains(tt.item) {
+			t.Errorf("test: %s want %v removed", tt.description, tt.item)
+		}
+	}
+}
+
+func TestPersistentSet_HashSet(t *testing.T) {
+	h := NewHashSet(1, 2, 3)
+	s := NewPersistentSetFrom[int](h)
+	if content := psContent(s); !reflect.DeepEqual(content, map[int]bool{1: true, 2: true, 3: true}) {
+		t.Errorf("test: from HashSet want %v got %v", h, content)
+	}
+	back := s.Dissoc(2).ToHashSet()
+	if back.Size() != 2 || !back.Contains(1) || !back.Contains(3) {
+		t.Errorf("test: to HashSet want %v got %v", "[1, 3]", back)
+	}
+	if str := NewPersistentSet(1).String(); str != "[1]" {
+		t.Errorf("test: String want %s got %s", "[1]", str)
+	}
+}
@@ -0,0 +1,504 @@
+package collection
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+)
+
+const (
+	pvBits  = 5
+	pvWidth = 1 << pvBits
+
+	// pvSlack is how many nodes a level may have beyond the minimum before Concat repacks it.
+	pvSlack = 2
+)
+
+// pvEdit marks the nodes a TransientVector owns and may change in place,
+// it must not be zero sized so that every token has its own address.
+type pvEdit struct {
+	_ byte
+}
+
+// pvNode is either a leaf holding up to pvWidth items or a branch holding up
+// to pvWidth children along with their cumulative sizes. The sizes make the
+// tree relaxed: children do not need to be full, which is what allows Slice
+// and Concat without copying whole levels.
+type pvNode[E any] struct {
+	items    []E
+	children []*pvNode[E]
+	sizes    []int
+	edit     *pvEdit
+}
+
+func newPVLeaf[E any](items []E, edit *pvEdit) *pvNode[E] {
+	return &pvNode[E]{items: pvClone(items, edit), edit: edit}
+}
+
+func newPVBranch[E any](children []*pvNode[E], edit *pvEdit) *pvNode[E] {
+	n := &pvNode[E]{children: pvClone(children, edit), edit: edit}
+	n.sizes = make([]int, len(children), cap(n.children))
+	total := 0
+	for i, child := range children {
+		total += child.size()
+		n.sizes[i] = total
+	}
+	return n
+}
+
+// pvClone copies s leaving room for one more element, or for a full node
+// when the copy belongs to a transient which is likely to fill it.
+func pvClone[T any](s []T, edit *pvEdit) []T {
+	c := len(s) + 1
+	if edit != nil {
+		c = max(c, pvWidth)
+	}
+	return append(make([]T, 0, c), s...)
+}
+
+func (n *pvNode[E]) size() int {
+	if n.children == nil {
+		return len(n.items)
+	}
+	return n.sizes[len(n.sizes)-1]
+}
+
+// editable returns n itself when it is owned by edit, otherwise a copy owned by edit.
+func (n *pvNode[E]) editable(edit *pvEdit) *pvNode[E] {
+	if edit != nil && n.edit == edit {
+		return n
+	}
+	if n.children == nil {
+		return newPVLeaf(n.items, edit)
+	}
+	return &pvNode[E]{children: pvClone(n.children, edit), sizes: pvClone(n.sizes, edit), edit: edit}
+}
+
+// childIndex returns the child of a branch at height h holding the i-th item,
+// a child holds at most pvWidth^h items so the search starts from there.
+func (n *pvNode[E]) childIndex(i, h int) int {
+	j := min(i>>(pvBits*h), len(n.sizes)-1)
+	for n.sizes[j] <= i {
+		j++
+	}
+	return j
+}
+
+func (n *pvNode[E]) offset(j int) int {
+	if j == 0 {
+		return 0
+	}
+	return n.sizes[j-1]
+}
+
+// leafFor returns the leaf holding the i-th item of the tree rooted at n of
+// height h along with the position of the item in the leaf.
+func (n *pvNode[E]) leafFor(i, h int) (*pvNode[E], int) {
+	for ; h > 0; h-- {
+		j := n.childIndex(i, h)
+		i -= n.offset(j)
+		n = n.children[j]
+	}
+	return n, i
+}
+
+func (n *pvNode[E]) set(i, h int, item E, edit *pvEdit) *pvNode[E] {
+	m := n.editable(edit)
+	if h == 0 {
+		m.items[i] = item
+		return m
+	}
+	j := n.childIndex(i, h)
+	m.children[j] = n.children[j].set(i-n.offset(j), h-1, item, edit)
+	return m
+}
+
+// pushLast appends item to the rightmost leaf below n, when there is no room
+// left it returns n unchanged and a new node of the same height holding item.
+func (n *pvNode[E]) pushLast(item E, h int, edit *pvEdit) (*pvNode[E], *pvNode[E]) {
+	if h == 0 {
+		if len(n.items) == pvWidth {
+			return n, newPVLeaf([]E{item}, edit)
+		}
+		m := n.editable(edit)
+		m.items = append(m.items, item)
+		return m, nil
+	}
+
+	last := len(n.children) - 1
+	child, overflow := n.children[last].pushLast(item, h-1, edit)
+	if overflow == nil {
+		m := n.editable(edit)
+		m.children[last] = child
+		m.sizes[last]++
+		return m, nil
+	}
+	if len(n.children) == pvWidth {
+		return n, newPVBranch([]*pvNode[E]{overflow}, edit)
+	}
+	m := n.editable(edit)
+	m.children = append(m.children, overflow)
+	m.sizes = append(m.sizes, m.sizes[last]+1)
+	return m, nil
+}
+
+// takeFirst returns the tree holding the first k items of n, 0 < k <= n.size().
+func (n *pvNode[E]) takeFirst(k, h int) *pvNode[E] {
+	if k == n.size() {
+		return n
+	}
+	if h == 0 {
+		return newPVLeaf(n.items[:k], nil)
+	}
+	j := n.childIndex(k-1, h)
+	children := append(slices.Clone(n.children[:j]), n.children[j].takeFirst(k-n.offset(j), h-1))
+	return newPVBranch(children, nil)
+}
+
+// dropFirst returns the tree without the first k items of n, 0 <= k < n.size().
+func (n *pvNode[E]) dropFirst(k, h int) *pvNode[E] {
+	if k == 0 {
+		return n
+	}
+	if h == 0 {
+		return newPVLeaf(n.items[k:], nil)
+	}
+	j := n.childIndex(k, h)
+	children := append([]*pvNode[E]{n.children[j].dropFirst(k-n.offset(j), h-1)}, n.children[j+1:]...)
+	return newPVBranch(children, nil)
+}
+
+// pvConcat joins the trees l of height hl and r of height hr, it returns one
+// or two nodes of height max(hl, hr). Only the nodes along the right edge of
+// l and the left edge of r are rebuilt, and they are rebalanced on the way
+// so that the tree does not fill up with small nodes.
+func pvConcat[E any](l *pvNode[E], hl int, r *pvNode[E], hr int) []*pvNode[E] {
+	switch {
+	case hl > hr:
+		last := len(l.children) - 1
+		middle := pvConcat(l.children[last], hl-1, r, hr)
+		return pvPack(slices.Concat(l.children[:last], middle), hl-1)
+	case hl < hr:
+		middle := pvConcat(l, hl, r.children[0], hr-1)
+		return pvPack(slices.Concat(middle, r.children[1:]), hr-1)
+	case hl == 0:
+		return pvChunk(slices.Concat(l.items, r.items), newPVLeaf[E])
+	default:
+		last := len(l.children) - 1
+		middle := pvConcat(l.children[last], hl-1, r.children[0], hr-1)
+		return pvPack(slices.Concat(l.children[:last], middle, r.children[1:]), hl-1)
+	}
+}
+
+// pvPack groups children of height h, at most 2*pvWidth of them, in one or
+// two branches. When there are more than pvSlack children beyond the fewest
+// that could hold their content, the content is first moved to full nodes.
+func pvPack[E any](children []*pvNode[E], h int) []*pvNode[E] {
+	content := 0
+	for _, child := range children {
+		content += len(child.items) + len(child.children)
+	}
+	if len(children) > (content+pvWidth-1)/pvWidth+pvSlack {
+		if h == 0 {
+			var items []E
+			for _, child := range children {
+				items = append(items, child.items...)
+			}
+			children = pvChunk(items, newPVLeaf[E])
+		} else {
+			var grandchildren []*pvNode[E]
+			for _, child := range children {
+				grandchildren = append(grandchildren, child.children...)
+			}
+			children = pvChunk(grandchildren, newPVBranch[E])
+		}
+	}
+	return pvChunk(children, newPVBranch[E])
+}
+
+// pvChunk splits s in nodes of pvWidth elements built by node.
+func pvChunk[T any, E any](s []T, node func(s []T, edit *pvEdit) *pvNode[E]) []*pvNode[E] {
+	var nodes []*pvNode[E]
+	for len(s) > pvWidth {
+		nodes = append(nodes, node(s[:pvWidth], nil))
+		s = s[pvWidth:]
+	}
+	return append(nodes, node(s, nil))
+}
+
+// PersistentVector is an immutable list, every change returns a new vector
+// sharing most of its nodes with the old one which stays valid. It is a
+// relaxed radix balanced tree with 32 children per node so that GetAt, Set,
+// Append, Slice and Concat take O(log32 n). A PersistentVector is safe for
+// concurrent use.
+type PersistentVector[E any] struct {
+	root   *pvNode[E]
+	size   int
+	height int
+	equal  EqualFunc[E]
+}
+
+func NewPersistentVector[E any](items ...E) *PersistentVector[E] {
+	t := (&PersistentVector[E]{}).Transient()
+	t.Append(items...)
+	return t.Persistent()
+}
+
+// NewPersistentVectorWith creates a vector configured with the given options,
+// it holds the items given by WithItems. Every vector derived from it keeps
+// the options.
+func NewPersistentVectorWith[E any](opts ...Option[E]) *PersistentVector[E] {
+	o := newOptions(opts...)
+	t := (&PersistentVector[E]{equal: o.equal}).Transient()
+	t.Append(o.items...)
+	return t.Persistent()
+}
+
+func (v *PersistentVector[E]) eq() EqualFunc[E] {
+	if v.equal == nil {
+		return DefaultEqual[E]()
+	}
+	return v.equal
+}
+
+// Transient returns a mutable builder starting from the items of the vector,
+// which is left untouched.
+func (v *PersistentVector[E]) Transient() *TransientVector[E] {
+	return &TransientVector[E]{root: v.root, size: v.size, height: v.height, equal: v.equal, edit: &pvEdit{}}
+}
+
+func (v *PersistentVector[E]) Iterator() Iterator[E] {
+	if v.Empty() {
+		return &emptyListIterator[E]{}
+	}
+	return &pvIterator[E]{root: v.root, size: v.size, height: v.height}
+}
+
+// All returns a sequence over the items of the vector.
+func (v *PersistentVector[E]) All() iter.Seq[E] {
+	return Seq(v.Iterator())
+}
+
+// Enumerate returns a sequence over the items of the vector along with their position.
+func (v *PersistentVector[E]) Enumerate() iter.Seq2[int, E] {
+	return Seq2(v.Iterator())
+}
+
+func (v *PersistentVector[E]) Empty() bool {
+	return v.Size() == 0
+}
+
+func (v *PersistentVector[E]) Size() int {
+	return v.size
+}
+
+func (v *PersistentVector[E]) Back() (E, error) {
+	return v.GetAt(v.size - 1)
+}
+
+func (v *PersistentVector[E]) Front() (E, error) {
+	return v.GetAt(0)
+}
+
+func (v *PersistentVector[E]) GetAt(pos int) (E, error) {
+	if err := v.checkPosition(pos); err != nil {
+		return *new(E), err
+	}
+	leaf, i := v.root.leafFor(pos, v.height)
+	return leaf.items[i], nil
+}
+
+func (v *PersistentVector[E]) checkPosition(pos int) error {
+	if v.Empty() {
+		return ErrEmptyCollection
+	}
+	if pos < 0 {
+		return ErrPositionNegative
+	}
+	if pos >= v.size {
+		return ErrIndexOutOfBound{pos, v.size}
+	}
+	return nil
+}
+
+func (v *PersistentVector[E]) Contains(item E) bool {
+	_, err := v.Index(item)
+	return err == nil
+}
+
+func (v *PersistentVector[E]) Index(item E) (int, error) {
+	eq := v.eq()
+	for i, x := range v.Enumerate() {
+		if eq(x, item) {
+			return i, nil
+		}
+	}
+	return 0, ErrItemNotFound{item}
+}
+
+// Set returns a new vector where the item at pos is replaced by item.
+func (v *PersistentVector[E]) Set(item E, pos int) (*PersistentVector[E], error) {
+	if err := v.checkPosition(pos); err != nil {
+		return nil, err
+	}
+	return &PersistentVector[E]{root: v.root.set(pos, v.height, item, nil), size: v.size, height: v.height, equal: v.equal}, nil
+}
+
+// Append returns a new vector with items added at the back, many items are
+// added through a transient.
+func (v *PersistentVector[E]) Append(items ...E) *PersistentVector[E] {
+	if len(items) > 1 {
+		t := v.Transient()
+		t.Append(items...)
+		return t.Persistent()
+	}
+	w := &PersistentVector[E]{root: v.root, size: v.size, height: v.height, equal: v.equal}
+	for _, item := range items {
+		w.root, w.height = pvAppend(w.root, w.height, item, nil)
+		w.size++
+	}
+	return w
+}
+
+func pvAppend[E any](root *pvNode[E], height int, item E, edit *pvEdit) (*pvNode[E], int) {
+	if root == nil {
+		return newPVLeaf([]E{item}, edit), 0
+	}
+	root, overflow := root.pushLast(item, height, edit)
+	if overflow != nil {
+		return newPVBranch([]*pvNode[E]{root, overflow}, edit), height + 1
+	}
+	return root, height
+}
+
+// Slice returns a new vector with the items from position from included to
+// position to excluded, but if the positions are not 0 <= from <= to <= size
+// well this method return ErrPositionNegative or ErrIndexOutOfBound error.
+func (v *PersistentVector[E]) Slice(from, to int) (*PersistentVector[E], error) {
+	if err := checkRange(from, to, v.size); err != nil {
+		return nil, err
+	}
+	if from == to {
+		return &PersistentVector[E]{equal: v.equal}, nil
+	}
+	root := v.root.takeFirst(to, v.height).dropFirst(from, v.height)
+	height := v.height
+	for height > 0 && len(root.children) == 1 {
+		root = root.children[0]
+		height--
+	}
+	return &PersistentVector[E]{root: root, size: to - from, height: height, equal: v.equal}, nil
+}
+
+// Concat returns a new vector with the items of v followed by the items of
+// other, it keeps the options of v.
+func (v *PersistentVector[E]) Concat(other *PersistentVector[E]) *PersistentVector[E] {
+	if other.Empty() {
+		return v
+	}
+	if v.Empty() {
+		return &PersistentVector[E]{root: other.root, size: other.size, height: other.height, equal: v.equal}
+	}
+	nodes := pvConcat(v.root, v.height, other.root, other.height)
+	height := max(v.height, other.height)
+	root := nodes[0]
+	if len(nodes) == 2 {
+		root = newPVBranch(nodes, nil)
+		height++
+	}
+	return &PersistentVector[E]{root: root, size: v.size + other.size, height: height, equal: v.equal}
+}
+
+func (v *PersistentVector[E]) String() string {
+	var sb strings.Builder
+	sb.WriteString("[")
+	for i, item := range v.Enumerate() {
+		if i >= v.Size()-1 {
+			sb.WriteString(fmt.Sprintf("%v", item))
+		} else {
+			sb.WriteString(fmt.Sprintf("%v, ", item))
+		}
+	}
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// TransientVector builds a PersistentVector changing its own nodes in place
+// instead of copying them, which makes bulk construction much cheaper. It
+// is not safe for concurrent use.
+type TransientVector[E any] struct {
+	root   *pvNode[E]
+	size   int
+	height int
+	equal  EqualFunc[E]
+	edit   *pvEdit
+}
+
+func (t *TransientVector[E]) Size() int {
+	return t.size
+}
+
+func (t *TransientVector[E]) GetAt(pos int) (E, error) {
+	return t.snapshot().GetAt(pos)
+}
+
+// Append adds items at the back.
+func (t *TransientVector[E]) Append(items ...E) {
+	for _, item := range items {
+		t.root, t.height = pvAppend(t.root, t.height, item, t.edit)
+		t.size++
+	}
+}
+
+// Set replaces the item at pos, but if pos is out of range well this method
+// return ErrPositionNegative or ErrIndexOutOfBound error.
+func (t *TransientVector[E]) Set(item E, pos int) error {
+	if err := t.snapshot().checkPosition(pos); err != nil {
+		return err
+	}
+	t.root = t.root.set(pos, t.height, item, t.edit)
+	return nil
+}
+
+// Persistent returns a vector with the current items. The transient can
+// still be used afterwards, it then copies the nodes it shares with the
+// returned vector before changing them.
+func (t *TransientVector[E]) Persistent() *PersistentVector[E] {
+	t.edit = &pvEdit{}
+	return t.snapshot()
+}
+
+func (t *TransientVector[E]) snapshot() *PersistentVector[E] {
+	return &PersistentVector[E]{root: t.root, size: t.size, height: t.height, equal: t.equal}
+}
+
+// pvIterator walks a vector leaf by leaf, it looks the next leaf up only
+// once every leaf.
+type pvIterator[E any] struct {
+	root   *pvNode[E]
+	size   int
+	height int
+	index  int
+	leaf   []E
+}
+
+func (it *pvIterator[E]) HasNext() bool {
+	return it.index < it.size
+}
+
+func (it *pvIterator[E]) Next() E {
+	_, item := it.NextWithIndex()
+	return item
+}
+
+func (it *pvIterator[E]) NextWithIndex() (int, E) {
+	if len(it.leaf) == 0 {
+		leaf, i := it.root.leafFor(it.index, it.height)
+		it.leaf = leaf.items[i:]
+	}
+	index, item := it.index, it.leaf[0]
+	it.leaf = it.leaf[1:]
+	it.index++
+	return index, item
+}
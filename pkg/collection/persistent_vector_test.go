@@ -0,0 +1,296 @@
+package collection
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func pvItems[E any](v *PersistentVector[E]) []E {
+	items := make([]E, 0, v.Size())
+	for it := v.Iterator(); it.HasNext(); {
+		items = append(items, it.Next())
+	}
+	return items
+}
+
+func rangeItems(from, to int) []int {
+	items := make([]int, 0, to-from)
+	for i := from; i < to; i++ {
+		items = append(items, i)
+	}
+	return items
+}
+
+func TestPersistentVector_GetAt(t *testing.T) {
+	useCases := []struct {
+		description string
+		vector      *PersistentVector[int]
+		pos         int
+		want        int
+		err         error
+	}{
+		{description: "get first item", vector: NewPersistentVector(1, 2, 3), pos: 0, want: 1},
+		{description: "get last item", vector: NewPersistentVector(1, 2, 3), pos: 2, want: 3},
+		{description: "get item in a deep tree", vector: NewPersistentVector(rangeItems(0, 40000)...), pos: 33333, want: 33333},
+		{description: "empty vector", vector: NewPersistentVector[int](), pos: 0, err: ErrEmptyCollection},
+		{description: "negative position", vector: NewPersistentVector(1), pos: -1, err: ErrPositionNegative},
+		{description: "position out of bound", vector: NewPersistentVector(1), pos: 1, err: ErrIndexOutOfBound{1, 1}},
+	}
+
+	for _, tt := range useCases {
+		result, err := tt.vector.GetAt(tt.pos)
+		if result != tt.want || err != tt.err {
+			t.Errorf("test: %s want (%v, %v) got (%v, %v)", tt.description, tt.want, tt.err, result, err)
+		}
+	}
+}
+
+func TestPersistentVector_Append(t *testing.T) {
+	useCases := []struct {
+		description string
+		size        int
+	}{
+		{description: "one leaf", size: pvWidth},
+		{description: "two levels", size: pvWidth + 1},
+		{description: "full two levels", size: pvWidth * pvWidth},
+		{description: "three levels", size: pvWidth*pvWidth + 1},
+	}
+
+	for _, tt := range useCases {
+		versions := []*PersistentVector[int]{NewPersistentVector[int]()}
+		for i := 0; i < tt.size; i++ {
+			versions = append(versions, versions[i].Append(i))
+		}
+		for size, v := range versions {
+			if !reflect.DeepEqual(pvItems(v), rangeItems(0, size)) {
+				t.Errorf("test: %s version %d want %v items got %v", tt.description, size, size, v.Size())
+				break
+			}
+		}
+		if bulk := NewPersistentVector(rangeItems(0, tt.size)...); !reflect.DeepEqual(pvItems(bulk), rangeItems(0, tt.size)) {
+			t.Errorf("test: %s bulk want %v items got %v", tt.description, tt.size, bulk.Size())
+		}
+	}
+}
+
+func TestPersistentVector_Set(t *testing.T) {
+	useCases := []struct {
+		description string
+		vector      *PersistentVector[int]
+		pos         int
+		err         error
+	}{
+		{description: "set first item", vector: NewPersistentVector(rangeItems(0, 100)...), pos: 0},
+		{description: "set item in the middle", vector: NewPersistentVector(rangeItems(0, 100)...), pos: 50},
+		{description: "set in empty vector", vector: NewPersistentVector[int](), pos: 0, err: ErrEmptyCollection},
+		{description: "set out of bound", vector: NewPersistentVector(1), pos: 3, err: ErrIndexOutOfBound{3, 1}},
+	}
+
+	for _, tt := range useCases {
+		before := pvItems(tt.vector)
+		result, err := tt.vector.Set(-1, tt.pos)
+		if err != tt.err {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.err, err)
+			continue
+		}
+		if !reflect.DeepEqual(pvItems(tt.vector), before) {
+			t.Errorf("test: %s old version changed", tt.description)
+		}
+		if err == nil {
+			if item, _ := result.GetAt(tt.pos); item != -1 || result.Size() != tt.vector.Size() {
+				t.Errorf("test: %s want %v got %v", tt.description, -1, item)
+			}
+		}
+	}
+}
+
+func TestPersistentVector_Slice(t *testing.T) {
+	v := NewPersistentVector(rangeItems(0, 5000)...)
+	useCases := []struct {
+		description string
+		from, to    int
+		err         error
+	}{
+		{description: "whole vector", from: 0, to: 5000},
+		{description: "empty slice", from: 10, to: 10},
+		{description: "inside one leaf", from: 3, to: 9},
+		{description: "across leaves", from: 30, to: 1100},
+		{description: "prefix", from: 0, to: 1025},
+		{description: "suffix", from: 1023, to: 5000},
+		{description: "negative position", from: -1, to: 3, err: ErrPositionNegative},
+		{description: "end out of bound", from: 0, to: 5001, err: ErrIndexOutOfBound{5001, 5000}},
+		{description: "start after end", from: 4, to: 3, err: ErrIndexOutOfBound{4, 3}},
+	}
+
+	for _, tt := range useCases {
+		result, err := v.Slice(tt.from, tt.to)
+		if err != tt.err {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.err, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if !reflect.DeepEqual(pvItems(result), rangeItems(tt.from, tt.to)) {
+			t.Errorf("test: %s want %v items got %v", tt.description, tt.to-tt.from, result.Size())
+		}
+		if appended := result.Append(-1); appended.Size() != tt.to-tt.from+1 {
+			t.Errorf("test: %s append after slice want %v got %v", tt.description, tt.to-tt.from+1, appended.Size())
+		}
+	}
+	if v.Size() != 5000 || !reflect.DeepEqual(pvItems(v), rangeItems(0, 5000)) {
+		t.Errorf("test: sliced vector changed")
+	}
+}
+
+func TestPersistentVector_Concat(t *testing.T) {
+	useCases := []struct {
+		description string
+		left, right int
+	}{
+		{description: "empty vectors", left: 0, right: 0},
+		{description: "empty left", left: 0, right: 10},
+		{description: "empty right", left: 10, right: 0},
+		{description: "two leaves", left: 20, right: 20},
+		{description: "deeper left", left: 5000, right: 7},
+		{description: "deeper right", left: 7, right: 5000},
+		{description: "same height", left: 1500, right: 2500},
+	}
+
+	for _, tt := range useCases {
+		left := NewPersistentVector(rangeItems(0, tt.left)...)
+		right := NewPersistentVector(rangeItems(tt.left, tt.left+tt.right)...)
+		result := left.Concat(right)
+		if !reflect.DeepEqual(pvItems(result), rangeItems(0, tt.left+tt.right)) {
+			t.Errorf("test: %s want %v items got %v", tt.description, tt.left+tt.right, result.Size())
+		}
+		if left.Size() != tt.left || right.Size() != tt.right {
+			t.Errorf("test: %s concatenated vectors changed", tt.description)
+		}
+	}
+}
+
+func TestPersistentVector_ConcatHeight(t *testing.T) {
+	useCases := []struct {
+		description string
+		concat      func(v, small *PersistentVector[int]) *PersistentVector[int]
+	}{
+		{description: "concat at the back", concat: func(v, small *PersistentVector[int]) *PersistentVector[int] { return v.Concat(small) }},
+		{description: "concat at the front", concat: func(v, small *PersistentVector[int]) *PersistentVector[int] { return small.Concat(v) }},
+	}
+
+	for _, tt := range useCases {
+		v := NewPersistentVector[int]()
+		for i := 0; i < 2000; i++ {
+			v = tt.concat(v, NewPersistentVector(rangeItems(0, 50)...))
+		}
+		// 100000 items fit in 4 levels of full nodes
+		if v.Size() != 100000 || v.height > 4 {
+			t.Errorf("test: %s want height at most %v got %v", tt.description, 4, v.height)
+		}
+	}
+}
+
+func TestPersistentVector_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	v, want := NewPersistentVector[int](), []int(nil)
+	for step := 0; step < 3000; step++ {
+		before, beforeItems := v, slices.Clone(want)
+		switch op := r.Intn(10); {
+		case op < 4:
+			v, want = v.Append(step), append(want, step)
+		case op < 6 && len(want) > 0:
+			pos := r.Intn(len(want))
+			v, _ = v.Set(-step, pos)
+			want[pos] = -step
+		case op < 8 && len(want) > 0:
+			from := r.Intn(len(want))
+			to := from + r.Intn(len(want)-from+1)
+			v, _ = v.Slice(from, to)
+			want = slices.Clone(want[from:to])
+		default:
+			n := r.Intn(100)
+			v, want = v.Concat(NewPersistentVector(rangeItems(step, step+n)...)), append(want, rangeItems(step, step+n)...)
+		}
+		if !slices.Equal(pvItems(before), beforeItems) {
+			t.Fatalf("test: step %d old version changed", step)
+		}
+		if v.Size() != len(want) || !slices.Equal(pvItems(v), want) {
+			t.Fatalf("test: step %d want %v items got %v", step, len(want), v.Size())
+		}
+		for i := 0; i < len(want); i += 97 {
+			if item, _ := v.GetAt(i); item != want[i] {
+				t.Fatalf("test: step %d item at %d want %v got %v", step, i, want[i], item)
+			}
+		}
+	}
+}
+
+func TestTransientVector(t *testing.T) {
+	base := NewPersistentVector(rangeItems(0, 100)...)
+	tr := base.Transient()
+	tr.Append(rangeItems(100, 2000)...)
+	if err := tr.Set(-1, 0); err != nil {
+		t.Errorf("test: set want %v got %v", nil, err)
+	}
+	first := tr.Persistent()
+
+	tr.Append(-2)
+	tr.Set(-3, 1)
+	second := tr.Persistent()
+
+	want := rangeItems(0, 2000)
+	want[0] = -1
+	if !reflect.DeepEqual(pvItems(first), want) {
+		t.Errorf("test: first version changed by the transient")
+	}
+	if !reflect.DeepEqual(pvItems(base), rangeItems(0, 100)) {
+		t.Errorf("test: base version changed by the transient")
+	}
+	want[1] = -3
+	if !reflect.DeepEqual(pvItems(second), append(want, -2)) {
+		t.Errorf("test: second version want %v items got %v", len(want)+1, second.Size())
+	}
+	if err := tr.Set(0, 5000); err != (ErrIndexOutOfBound{5000, 2001}) {
+		t.Errorf("test: set out of bound want %v got %v", ErrIndexOutOfBound{5000, 2001}, err)
+	}
+	if item, _ := tr.GetAt(1); item != -3 || tr.Size() != 2001 {
+		t.Errorf("test: get want %v got %v", -3, item)
+	}
+}
+
+func TestPersistentVector_Read(t *testing.T) {
+	v := NewPersistentVector("a", "b", "c")
+	if i, err := v.Index("c"); i != 2 || err != nil {
+		t.Errorf("test: index want (%v, %v) got (%v, %v)", 2, nil, i, err)
+	}
+	if v.Contains("d") || !v.Contains("a") {
+		t.Errorf("test: contains want %v got %v", false, v.Contains("d"))
+	}
+	if front, _ := v.Front(); front != "a" {
+		t.Errorf("test: front want %v got %v", "a", front)
+	}
+	if back, _ := v.Back(); back != "c" {
+		t.Errorf("test: back want %v got %v", "c", back)
+	}
+	if v.String() != "[a, b, c]" {
+		t.Errorf("test: string want %v got %v", "[a, b, c]", v.String())
+	}
+}
+
+func BenchmarkPersistentVector_Append(b *testing.B) {
+	for b.Loop() {
+		v := NewPersistentVector[int]()
+		for i := 0; i < 10000; i++ {
+			v = v.Append(i)
+		}
+	}
+}
+
+func BenchmarkTransientVector_Append(b *testing.B) {
+	for b.Loop() {
+		NewPersistentVector(rangeItems(0, 10000)...)
+	}
+}
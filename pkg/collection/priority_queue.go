@@ -0,0 +1,201 @@
+package collection
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// PriorityQueue is a binary heap where the item at the top is always the
+// lowest according to the comparator given at construction time.
+type PriorityQueue[E any] struct {
+	items   []E
+	compare Comparator[E]
+	equal   EqualFunc[E]
+	mods    int
+}
+
+// NewPriorityQueue creates a PriorityQueue ordered by the natural order of the items.
+func NewPriorityQueue[E cmp.Ordered](items ...E) *PriorityQueue[E] {
+	return NewPriorityQueueWithComparator(NaturalOrder[E](), items...)
+}
+
+// NewPriorityQueueWithComparator creates a PriorityQueue ordered by compare,
+// use ReverseOrder to get a max-heap.
+func NewPriorityQueueWithComparator[E any](compare Comparator[E], items ...E) *PriorityQueue[E] {
+	return NewPriorityQueueWith(compare, WithItems(items...))
+}
+
+// NewPriorityQueueWith creates a PriorityQueue ordered by compare and
+// configured with the given options, it holds the items given by WithItems.
+func NewPriorityQueueWith[E any](compare Comparator[E], opts ...Option[E]) *PriorityQueue[E] {
+	o := newOptions(opts...)
+	q := &PriorityQueue[E]{items: o.items, compare: compare, equal: o.equal}
+	for i := len(q.items)/2 - 1; i >= 0; i-- {
+		heapDown(i, len(q.items), q.less, q.swap)
+	}
+	return q
+}
+
+func (q *PriorityQueue[E]) eq() EqualFunc[E] {
+	if q.equal == nil {
+		return DefaultEqual[E]()
+	}
+	return q.equal
+}
+
+func (q *PriorityQueue[E]) less(i, j int) bool {
+	return q.compare(q.items[i], q.items[j]) < 0
+}
+
+func (q *PriorityQueue[E]) swap(i, j int) {
+	q.items[i], q.items[j] = q.items[j], q.items[i]
+}
+
+// Iterator returns an iterator over the items of the queue in heap order,
+// which is not the order they would be popped.
+func (q *PriorityQueue[E]) Iterator() Iterator[E] {
+	if q.Empty() {
+		return &emptyListIterator[E]{}
+	}
+	return newSliceIterator[E](&q.items, &q.mods, nil)
+}
+
+// All returns a sequence over the items of the queue in heap order.
+func (q *PriorityQueue[E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		mods := q.mods
+		for _, item := range q.items {
+			if !yield(item) {
+				return
+			}
+			checkModifications(mods, q.mods)
+		}
+	}
+}
+
+// Enumerate returns a sequence over the items of the queue in heap order along with their position.
+func (q *PriorityQueue[E]) Enumerate() iter.Seq2[int, E] {
+	return enumerate(q.All())
+}
+
+func (q *PriorityQueue[E]) Empty() bool {
+	return q.Size() == 0
+}
+
+func (q *PriorityQueue[E]) Size() int {
+	return len(q.items)
+}
+
+// Push adds an item to the queue in O(log n).
+func (q *PriorityQueue[E]) Push(item E) {
+	q.items = append(q.items, item)
+	heapUp(len(q.items)-1, q.less, q.swap)
+	q.mods++
+}
+
+// Peek get the lowest item of the queue, but
+// if queue is empty well this method return ErrEmptyCollection error.
+func (q *PriorityQueue[E]) Peek() (E, error) {
+	if q.Empty() {
+		return *new(E), ErrEmptyCollection
+	}
+	return q.items[0], nil
+}
+
+// Pop get and remove the lowest item of the queue in O(log n), but
+// if queue is empty well this method return ErrEmptyCollection error.
+func (q *PriorityQueue[E]) Pop() (E, error) {
+	if q.Empty() {
+		return *new(E), ErrEmptyCollection
+	}
+	return q.removeAt(0), nil
+}
+
+func (q *PriorityQueue[E]) removeAt(i int) E {
+	n := len(q.items) - 1
+	item := q.items[i]
+	if i != n {
+		q.swap(i, n)
+		if !heapDown(i, n, q.less, q.swap) {
+			heapUp(i, q.less, q.swap)
+		}
+	}
+	q.items[n] = *new(E)
+	q.items = q.items[:n]
+	q.mods++
+	return item
+}
+
+func (q *PriorityQueue[E]) Contains(item E) bool {
+	eq := q.eq()
+	for _, x := range q.items {
+		if eq(x, item) {
+			return true
+		}
+	}
+	return false
+}
+
+// Delete removes an item from the queue, it takes linear time to find it.
+func (q *PriorityQueue[E]) Delete(item E) error {
+	eq := q.eq()
+	for i, x := range q.items {
+		if eq(x, item) {
+			q.removeAt(i)
+			return nil
+		}
+	}
+	return ErrItemNotFound{item}
+}
+
+func (q *PriorityQueue[E]) String() string {
+	var sb strings.Builder
+	sb.WriteString("[")
+	for i := 0; i < q.Size(); i++ {
+		var s string
+		item := q.items[i]
+		if i >= q.Size()-1 {
+			s = fmt.Sprintf("%v", item)
+		} else {
+			s = fmt.Sprintf("%v, ", item)
+		}
+		sb.WriteString(s)
+	}
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// heapUp moves the item at position i towards the root until its parent is not greater.
+func heapUp(i int, less func(i, j int) bool, swap func(i, j int)) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !less(i, parent) {
+			break
+		}
+		swap(i, parent)
+		i = parent
+	}
+}
+
+// heapDown moves the item at position i towards the leaves of a heap of
+// n items and reports whether the item has been moved.
+func heapDown(i, n int, less func(i, j int) bool, swap func(i, j int)) bool {
+	start := i
+	for {
+		child := 2*i + 1
+		if child >= n || child < 0 {
+			break
+		}
+		if right := child + 1; right < n && less(right, child) {
+			child = right
+		}
+		if !less(child, i) {
+			break
+		}
+		swap(i, child)
+		i = child
+	}
+	return i > start
+}
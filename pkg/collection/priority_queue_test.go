@@ -0,0 +1,113 @@
+package collection
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func drain[E any](q *PriorityQueue[E]) []E {
+	var items []E
+	for !q.Empty() {
+		item, _ := q.Pop()
+		items = append(items, item)
+	}
+	return items
+}
+
+func TestPriorityQueue_Pop(t *testing.T) {
+	useCases := []struct {
+		description string
+		queue       *PriorityQueue[int]
+		want        []int
+	}{
+		{description: "empty queue", queue: NewPriorityQueue[int](), want: nil},
+		{description: "min heap from constructor", queue: NewPriorityQueue(5, 1, 4, 2, 3), want: []int{1, 2, 3, 4, 5}},
+		{description: "max heap with reverse comparator", queue: NewPriorityQueueWithComparator(ReverseOrder(NaturalOrder[int]()), 5, 1, 4, 2, 3), want: []int{5, 4, 3, 2, 1}},
+		{description: "duplicated items", queue: NewPriorityQueue(2, 1, 2, 1), want: []int{1, 1, 2, 2}},
+	}
+
+	for _, tt := range useCases {
+		result := drain(tt.queue)
+		if !reflect.DeepEqual(result, tt.want) {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.want, result)
+		}
+	}
+}
+
+func TestPriorityQueue_Peek(t *testing.T) {
+	useCases := []struct {
+		description string
+		queue       *PriorityQueue[int]
+		item        int
+		err         error
+	}{
+		{description: "peek on empty queue", queue: NewPriorityQueue[int](), item: 0, err: ErrEmptyCollection},
+		{description: "peek on full queue", queue: NewPriorityQueue(3, 1, 2), item: 1, err: nil},
+	}
+
+	for _, tt := range useCases {
+		size := tt.queue.Size()
+		item, err := tt.queue.Peek()
+		if item != tt.item || err != tt.err || tt.queue.Size() != size {
+			t.Errorf("test: %s want {%v, %v} got {%v, %v}", tt.description, tt.item, tt.err, item, err)
+		}
+	}
+
+	if _, err := NewPriorityQueue[int]().Pop(); err != ErrEmptyCollection {
+		t.Errorf("test: pop on empty queue want %v got %v", ErrEmptyCollection, err)
+	}
+}
+
+func TestPriorityQueue_Push(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	q := NewPriorityQueue[int]()
+	var want []int
+	for i := 0; i < 200; i++ {
+		item := r.Intn(100)
+		q.Push(item)
+		want = append(want, item)
+	}
+	sort.Ints(want)
+	if q.Size() != len(want) {
+		t.Errorf("test: size want %v got %v", len(want), q.Size())
+	}
+	if result := drain(q); !reflect.DeepEqual(result, want) {
+		t.Errorf("test: push random items want %v got %v", want, result)
+	}
+}
+
+func TestPriorityQueue_Delete(t *testing.T) {
+	useCases := []struct {
+		description string
+		queue       *PriorityQueue[int]
+		item        int
+		want        []int
+		err         error
+	}{
+		{description: "delete missing item", queue: NewPriorityQueue(1, 2, 3), item: 4, want: []int{1, 2, 3}, err: ErrItemNotFound{4}},
+		{description: "delete top item", queue: NewPriorityQueue(1, 2, 3), item: 1, want: []int{2, 3}, err: nil},
+		{description: "delete inner item", queue: NewPriorityQueue(1, 5, 2, 6, 7, 3, 4), item: 5, want: []int{1, 2, 3, 4, 6, 7}, err: nil},
+	}
+
+	for _, tt := range useCases {
+		err := tt.queue.Delete(tt.item)
+		contains := tt.queue.Contains(tt.item)
+		result := drain(tt.queue)
+		if !reflect.DeepEqual(result, tt.want) || err != tt.err || contains {
+			t.Errorf("test: %s want {%v, %v} got {%v, %v}", tt.description, tt.want, tt.err, result, err)
+		}
+	}
+}
+
+func TestPriorityQueue_Collection(t *testing.T) {
+	var c Collection[int] = NewPriorityQueue(3, 1, 2)
+	sum := 0
+	for it := c.Iterator(); it.HasNext(); {
+		sum += it.Next()
+	}
+	if sum != 6 || !c.Contains(2) || c.Contains(4) {
+		t.Errorf("test: priority queue as collection want sum %v got %v", 6, sum)
+	}
+}
@@ -2,6 +2,7 @@ package collection
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 )
 
@@ -9,15 +10,48 @@ var (
 	ErrEmptyQueue = fmt.Errorf("empty queue")
 )
 
+const minQueueCapacity = 8
+
+// Queue is a FIFO structure backed by a growable circular buffer, the buffer
+// shrinks again when most of its slots are unused.
 type Queue[E any] struct {
-	items []E
+	buf     []E
+	head    int
+	size    int
+	initCap int
 }
 
+// NewQueue creates a queue holding items, its buffer fits them but it may
+// shrink down to the default capacity once they are dequeued.
 func NewQueue[E any](items ...E) *Queue[E] {
-	if items == nil {
-		items = make([]E, 0)
+	buf := make([]E, max(len(items), minQueueCapacity))
+	copy(buf, items)
+	return &Queue[E]{buf: buf, size: len(items), initCap: minQueueCapacity}
+}
+
+// NewQueueWithCapacity creates an empty queue whose buffer can hold capacity
+// items before growing, the buffer never shrinks below this capacity.
+func NewQueueWithCapacity[E any](capacity int) *Queue[E] {
+	if capacity < minQueueCapacity {
+		capacity = minQueueCapacity
+	}
+	return &Queue[E]{buf: make([]E, capacity), initCap: capacity}
+}
+
+// All returns a sequence over the items of the queue in FIFO order.
+func (q *Queue[E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for i := 0; i < q.size; i++ {
+			if !yield(q.buf[q.at(i)]) {
+				return
+			}
+		}
 	}
-	return &Queue[E]{items}
+}
+
+// Enumerate returns a sequence over the items of the queue in FIFO order along with their position.
+func (q *Queue[E]) Enumerate() iter.Seq2[int, E] {
+	return enumerate(q.All())
 }
 
 func (q *Queue[E]) Empty() bool {
@@ -25,28 +59,75 @@ func (q *Queue[E]) Empty() bool {
 }
 
 func (q *Queue[E]) Size() int {
-	return len(q.items)
+	return q.size
+}
+
+// at returns the buffer position of the i-th item of the queue.
+func (q *Queue[E]) at(i int) int {
+	return (q.head + i) % len(q.buf)
 }
 
 func (q *Queue[E]) Enqueue(item E) {
-	q.items = append(q.items, item)
+	if q.size == len(q.buf) {
+		q.resize(max(2*len(q.buf), minQueueCapacity))
+	}
+	q.buf[q.at(q.size)] = item
+	q.size++
 }
 
 func (q *Queue[E]) Dequeue() (E, error) {
 	if q.Empty() {
 		return *new(E), ErrEmptyQueue
 	}
-	item := q.items[0]
-	q.items = q.items[1:]
+	item := q.buf[q.head]
+	q.buf[q.head] = *new(E)
+	q.head = q.at(1)
+	q.size--
+	if q.size <= len(q.buf)/4 && len(q.buf) > q.minCapacity() {
+		q.resize(max(len(q.buf)/2, q.minCapacity()))
+	}
 	return item, nil
 }
 
+// Peek get first item in the queue without removing it, but
+// if queue is empty well this method return ErrEmptyQueue error.
+func (q *Queue[E]) Peek() (E, error) {
+	if q.Empty() {
+		return *new(E), ErrEmptyQueue
+	}
+	return q.buf[q.head], nil
+}
+
+// Clear removes all items and releases the buffer grown beyond the initial capacity.
+func (q *Queue[E]) Clear() {
+	q.buf = make([]E, q.minCapacity())
+	q.head = 0
+	q.size = 0
+}
+
+func (q *Queue[E]) minCapacity() int {
+	return max(q.initCap, minQueueCapacity)
+}
+
+// resize moves the items at the beginning of a new buffer of the given capacity.
+func (q *Queue[E]) resize(capacity int) {
+	buf := make([]E, capacity)
+	if q.head+q.size <= len(q.buf) {
+		copy(buf, q.buf[q.head:q.head+q.size])
+	} else {
+		n := copy(buf, q.buf[q.head:])
+		copy(buf[n:], q.buf[:q.size-n])
+	}
+	q.buf = buf
+	q.head = 0
+}
+
 func (q *Queue[E]) String() string {
 	var sb strings.Builder
 	sb.WriteString("[")
 	for i := 0; i < q.Size(); i++ {
 		var s string
-		item := q.items[i]
+		item := q.buf[q.at(i)]
 		if i >= q.Size()-1 {
 			s = fmt.Sprintf("%v", item)
 		} else {
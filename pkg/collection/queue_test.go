@@ -2,9 +2,18 @@ package collection
 
 import (
 	"reflect"
+	"runtime"
 	"testing"
 )
 
+func queueItems[E any](q *Queue[E]) []E {
+	items := make([]E, 0, q.Size())
+	for i := 0; i < q.Size(); i++ {
+		items = append(items, q.buf[q.at(i)])
+	}
+	return items
+}
+
 func TestQueue_Empty(t *testing.T) {
 	useCases := []struct {
 		description string
@@ -54,7 +63,7 @@ func TestQueue_Enqueue(t *testing.T) {
 
 	for _, tt := range useCases {
 		tt.original.Enqueue(tt.item)
-		if !reflect.DeepEqual(tt.original.items, tt.modified.items) {
+		if !reflect.DeepEqual(queueItems(tt.original), queueItems(tt.modified)) {
 			t.Errorf("test: %s, want %v got %v", tt.description, tt.modified, tt.original)
 		}
 	}
@@ -87,8 +96,141 @@ func TestQueue_Dequeue(t *testing.T) {
 
 	for _, tt := range useCases {
 		_, err := tt.original.Dequeue()
-		if !reflect.DeepEqual(tt.original.items, tt.modified.items) || err != tt.err {
+		if !reflect.DeepEqual(queueItems(tt.original), queueItems(tt.modified)) || err != tt.err {
 			t.Errorf("test: %s, want %v got %v", tt.description, tt.modified, tt.original)
 		}
 	}
 }
+
+func TestQueue_Peek(t *testing.T) {
+	useCases := []struct {
+		description string
+		queue       *Queue[int]
+		item        int
+		err         error
+	}{
+		{description: "peek on empty queue", queue: NewQueue[int](), item: 0, err: ErrEmptyQueue},
+		{description: "peek on full queue", queue: NewQueue(1, 2, 3), item: 1, err: nil},
+	}
+
+	for _, tt := range useCases {
+		size := tt.queue.Size()
+		item, err := tt.queue.Peek()
+		if item != tt.item || err != tt.err || tt.queue.Size() != size {
+			t.Errorf("test: %s, want {%v, %v} got {%v, %v}", tt.description, tt.item, tt.err, item, err)
+		}
+	}
+}
+
+func TestQueue_Clear(t *testing.T) {
+	queue := NewQueue(1, 2, 3)
+	queue.Clear()
+	if !queue.Empty() || queue.String() != "[]" {
+		t.Errorf("test: clear queue, want %v got %v", NewQueue[int](), queue)
+	}
+	queue.Enqueue(4)
+	if item, err := queue.Dequeue(); item != 4 || err != nil {
+		t.Errorf("test: reuse cleared queue, want {%v, %v} got {%v, %v}", 4, nil, item, err)
+	}
+}
+
+func TestQueue_WrapAround(t *testing.T) {
+	queue := NewQueueWithCapacity[int](4)
+	var want []int
+	next := 0
+	for round := 0; round < 50; round++ {
+		for i := 0; i < round%7+1; i++ {
+			queue.Enqueue(next)
+			want = append(want, next)
+			next++
+		}
+		for i := 0; i < round%5+1 && len(want) > 0; i++ {
+			item, err := queue.Dequeue()
+			if item != want[0] || err != nil {
+				t.Fatalf("test: dequeue in round %d, want {%v, %v} got {%v, %v}", round, want[0], nil, item, err)
+			}
+			want = want[1:]
+		}
+		if !reflect.DeepEqual(queueItems(queue), append([]int{}, want...)) {
+			t.Fatalf("test: items in round %d, want %v got %v", round, want, queue)
+		}
+	}
+}
+
+func TestQueue_Shrink(t *testing.T) {
+	queue := NewQueueWithCapacity[*int](16)
+	for i := 0; i < 1000; i++ {
+		queue.Enqueue(new(int))
+	}
+	for i := 0; i < 1000; i++ {
+		queue.Dequeue()
+	}
+	if len(queue.buf) != 16 {
+		t.Errorf("test: shrink to initial capacity, want %v got %v", 16, len(queue.buf))
+	}
+	for i, item := range queue.buf {
+		if item != nil {
+			t.Errorf("test: dequeued slot %d not released", i)
+		}
+	}
+}
+
+func TestQueue_ShrinkSeeded(t *testing.T) {
+	items := make([]int, 1000)
+	for i := range items {
+		items[i] = i
+	}
+	queue := NewQueue(items...)
+	if len(queue.buf) != 1000 {
+		t.Errorf("test: seeded buffer fits the items, want %v got %v", 1000, len(queue.buf))
+	}
+	for i := 0; i < 1000; i++ {
+		if item, _ := queue.Dequeue(); item != i {
+			t.Fatalf("test: dequeue seeded item, want %v got %v", i, item)
+		}
+	}
+	if len(queue.buf) != minQueueCapacity {
+		t.Errorf("test: shrink seeded queue to the default capacity, want %v got %v", minQueueCapacity, len(queue.buf))
+	}
+}
+
+// BenchmarkQueue_SteadyState enqueues and dequeues items through a queue
+// that always holds the same number of items, the heap must not grow.
+func BenchmarkQueue_SteadyState(b *testing.B) {
+	queue := NewQueueWithCapacity[[64]byte](2048)
+	for i := 0; i < 1024; i++ {
+		queue.Enqueue([64]byte{})
+	}
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		queue.Enqueue([64]byte{})
+		queue.Dequeue()
+	}
+	b.StopTimer()
+
+	runtime.GC()
+	runtime.ReadMemStats(&after)
+	b.ReportMetric(float64(int64(after.HeapInuse)-int64(before.HeapInuse)), "heap-growth-bytes")
+	b.ReportMetric(float64(len(queue.buf)), "capacity")
+}
+
+// BenchmarkQueue_Burst fills and empties the queue to show that the buffer
+// grown by the burst is released afterwards.
+func BenchmarkQueue_Burst(b *testing.B) {
+	queue := NewQueue[[64]byte]()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < 4096; j++ {
+			queue.Enqueue([64]byte{})
+		}
+		for !queue.Empty() {
+			queue.Dequeue()
+		}
+	}
+	b.ReportMetric(float64(len(queue.buf)), "capacity")
+}
@@ -0,0 +1,518 @@
+package collection
+
+type color bool
+
+const (
+	red   color = false
+	black color = true
+)
+
+type rbNode[K any, V any] struct {
+	key    K
+	value  V
+	color  color
+	left   *rbNode[K, V]
+	right  *rbNode[K, V]
+	parent *rbNode[K, V]
+}
+
+// rbTree is a red-black tree ordered by compare, it is the backing structure
+// of the sorted collections.
+type rbTree[K any, V any] struct {
+	root    *rbNode[K, V]
+	size    int
+	compare Comparator[K]
+	mods    int
+}
+
+func newRBTree[K any, V any](compare Comparator[K]) *rbTree[K, V] {
+	return &rbTree[K, V]{compare: compare}
+}
+
+// bound is one end of the range of a view over a tree, TreeMap and TreeSet
+// views keep a nil bound for an open end.
+type bound[K any] struct {
+	value     K
+	inclusive bool
+}
+
+// below reports whether key is before the lower bound lo.
+func (t *rbTree[K, V]) below(key K, lo *bound[K]) bool {
+	if lo == nil {
+		return false
+	}
+	c := t.compare(key, lo.value)
+	return c < 0 || (c == 0 && !lo.inclusive)
+}
+
+// above reports whether key is after the upper bound hi.
+func (t *rbTree[K, V]) above(key K, hi *bound[K]) bool {
+	if hi == nil {
+		return false
+	}
+	c := t.compare(key, hi.value)
+	return c > 0 || (c == 0 && !hi.inclusive)
+}
+
+// narrow returns the bounds of the intersection of the range from lo to hi
+// with the range from from to to.
+func (t *rbTree[K, V]) narrow(lo, hi, from, to *bound[K]) (*bound[K], *bound[K]) {
+	if from != nil && (lo == nil || t.tighter(from, lo, false)) {
+		lo = from
+	}
+	if to != nil && (hi == nil || t.tighter(to, hi, true)) {
+		hi = to
+	}
+	return lo, hi
+}
+
+// tighter reports whether bound a restricts the range more than bound b,
+// upper tells whether they are upper bounds. The operands are swapped rather
+// than the result negated, a comparator may return math.MinInt.
+func (t *rbTree[K, V]) tighter(a, b *bound[K], upper bool) bool {
+	c := t.compare(a.value, b.value)
+	if upper {
+		c = t.compare(b.value, a.value)
+	}
+	return c > 0 || (c == 0 && !a.inclusive)
+}
+
+func (t *rbTree[K, V]) find(key K) *rbNode[K, V] {
+	n := t.root
+	for n != nil {
+		c := t.compare(key, n.key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+// put inserts or replaces the value bound to key and reports whether a new node was created.
+func (t *rbTree[K, V]) put(key K, value V) bool {
+	var parent *rbNode[K, V]
+	c := 0
+	for n := t.root; n != nil; {
+		parent = n
+		c = t.compare(key, n.key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			n.value = value
+			return false
+		}
+	}
+
+	n := &rbNode[K, V]{key: key, value: value, parent: parent}
+	switch {
+	case parent == nil:
+		t.root = n
+	case c < 0:
+		parent.left = n
+	default:
+		parent.right = n
+	}
+	t.fixAfterInsertion(n)
+	t.size++
+	t.mods++
+	return true
+}
+
+func (t *rbTree[K, V]) remove(key K) (V, bool) {
+	n := t.find(key)
+	if n == nil {
+		return *new(V), false
+	}
+	value := n.value
+	t.deleteNode(n)
+	return value, true
+}
+
+func (t *rbTree[K, V]) first() *rbNode[K, V] {
+	n := t.root
+	if n == nil {
+		return nil
+	}
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+func (t *rbTree[K, V]) last() *rbNode[K, V] {
+	n := t.root
+	if n == nil {
+		return nil
+	}
+	for n.right != nil {
+		n = n.right
+	}
+	return n
+}
+
+// floor returns the greatest node with key less than or equal to key,
+// when inclusive is false it returns the greatest node strictly less than key.
+func (t *rbTree[K, V]) floor(key K, inclusive bool) *rbNode[K, V] {
+	var found *rbNode[K, V]
+	for n := t.root; n != nil; {
+		c := t.compare(key, n.key)
+		if c > 0 || (inclusive && c == 0) {
+			found = n
+			if c == 0 {
+				return n
+			}
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+	return found
+}
+
+// ceiling returns the least node with key greater than or equal to key,
+// when inclusive is false it returns the least node strictly greater than key.
+func (t *rbTree[K, V]) ceiling(key K, inclusive bool) *rbNode[K, V] {
+	var found *rbNode[K, V]
+	for n := t.root; n != nil; {
+		c := t.compare(key, n.key)
+		if c < 0 || (inclusive && c == 0) {
+			found = n
+			if c == 0 {
+				return n
+			}
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+	return found
+}
+
+func successor[K any, V any](n *rbNode[K, V]) *rbNode[K, V] {
+	if n == nil {
+		return nil
+	}
+	if n.right != nil {
+		p := n.right
+		for p.left != nil {
+			p = p.left
+		}
+		return p
+	}
+	p := n.parent
+	for p != nil && n == p.right {
+		n = p
+		p = p.parent
+	}
+	return p
+}
+
+func predecessor[K any, V any](n *rbNode[K, V]) *rbNode[K, V] {
+	if n == nil {
+		return nil
+	}
+	if n.left != nil {
+		p := n.left
+		for p.right != nil {
+			p = p.right
+		}
+		return p
+	}
+	p := n.parent
+	for p != nil && n == p.left {
+		n = p
+		p = p.parent
+	}
+	return p
+}
+
+func colorOf[K any, V any](n *rbNode[K, V]) color {
+	if n == nil {
+		return black
+	}
+	return n.color
+}
+
+func setColor[K any, V any](n *rbNode[K, V], c color) {
+	if n != nil {
+		n.color = c
+	}
+}
+
+func parentOf[K any, V any](n *rbNode[K, V]) *rbNode[K, V] {
+	if n == nil {
+		return nil
+	}
+	return n.parent
+}
+
+func leftOf[K any, V any](n *rbNode[K, V]) *rbNode[K, V] {
+	if n == nil {
+		return nil
+	}
+	return n.left
+}
+
+func rightOf[K any, V any](n *rbNode[K, V]) *rbNode[K, V] {
+	if n == nil {
+		return nil
+	}
+	return n.right
+}
+
+func (t *rbTree[K, V]) rotateLeft(p *rbNode[K, V]) {
+	if p == nil {
+		return
+	}
+	r := p.right
+	p.right = r.left
+	if r.left != nil {
+		r.left.parent = p
+	}
+	r.parent = p.parent
+	switch {
+	case p.parent == nil:
+		t.root = r
+	case p.parent.left == p:
+		p.parent.left = r
+	default:
+		p.parent.right = r
+	}
+	r.left = p
+	p.parent = r
+}
+
+func (t *rbTree[K, V]) rotateRight(p *rbNode[K, V]) {
+	if p == nil {
+		return
+	}
+	l := p.left
+	p.left = l.right
+	if l.right != nil {
+		l.right.parent = p
+	}
+	l.parent = p.parent
+	switch {
+	case p.parent == nil:
+		t.root = l
+	case p.parent.right == p:
+		p.parent.right = l
+	default:
+		p.parent.left = l
+	}
+	l.right = p
+	p.parent = l
+}
+
+func (t *rbTree[K, V]) fixAfterInsertion(x *rbNode[K, V]) {
+	x.color = red
+	for x != nil && x != t.root && x.parent.color == red {
+		if parentOf(x) == leftOf(parentOf(parentOf(x))) {
+			y := rightOf(parentOf(parentOf(x)))
+			if colorOf(y) == red {
+				setColor(parentOf(x), black)
+				setColor(y, black)
+				setColor(parentOf(parentOf(x)), red)
+				x = parentOf(parentOf(x))
+			} else {
+				if x == rightOf(parentOf(x)) {
+					x = parentOf(x)
+					t.rotateLeft(x)
+				}
+				setColor(parentOf(x), black)
+				setColor(parentOf(parentOf(x)), red)
+				t.rotateRight(parentOf(parentOf(x)))
+			}
+		} else {
+			y := leftOf(parentOf(parentOf(x)))
+			if colorOf(y) == red {
+				setColor(parentOf(x), black)
+				setColor(y, black)
+				setColor(parentOf(parentOf(x)), red)
+				x = parentOf(parentOf(x))
+			} else {
+				if x == leftOf(parentOf(x)) {
+					x = parentOf(x)
+					t.rotateRight(x)
+				}
+				setColor(parentOf(x), black)
+				setColor(parentOf(parentOf(x)), red)
+				t.rotateLeft(parentOf(parentOf(x)))
+			}
+		}
+	}
+	t.root.color = black
+}
+
+// deleteNode unlinks p from the tree, when p has two children its successor
+// is moved into p and the successor node is unlinked instead.
+func (t *rbTree[K, V]) deleteNode(p *rbNode[K, V]) {
+	t.size--
+	t.mods++
+	if p.left != nil && p.right != nil {
+		s := successor(p)
+		p.key = s.key
+		p.value = s.value
+		p = s
+	}
+
+	replacement := p.left
+	if replacement == nil {
+		replacement = p.right
+	}
+
+	switch {
+	case replacement != nil:
+		replacement.parent = p.parent
+		switch {
+		case p.parent == nil:
+			t.root = replacement
+		case p == p.parent.left:
+			p.parent.left = replacement
+		default:
+			p.parent.right = replacement
+		}
+		p.left, p.right, p.parent = nil, nil, nil
+		if p.color == black {
+			t.fixAfterDeletion(replacement)
+		}
+	case p.parent == nil:
+		t.root = nil
+	default:
+		if p.color == black {
+			t.fixAfterDeletion(p)
+		}
+		if p.parent != nil {
+			if p == p.parent.left {
+				p.parent.left = nil
+			} else if p == p.parent.right {
+				p.parent.right = nil
+			}
+			p.parent = nil
+		}
+	}
+}
+
+func (t *rbTree[K, V]) fixAfterDeletion(x *rbNode[K, V]) {
+	for x != t.root && colorOf(x) == black {
+		if x == leftOf(parentOf(x)) {
+			sib := rightOf(parentOf(x))
+			if colorOf(sib) == red {
+				setColor(sib, black)
+				setColor(parentOf(x), red)
+				t.rotateLeft(parentOf(x))
+				sib = rightOf(parentOf(x))
+			}
+			if colorOf(leftOf(sib)) == black && colorOf(rightOf(sib)) == black {
+				setColor(sib, red)
+				x = parentOf(x)
+			} else {
+				if colorOf(rightOf(sib)) == black {
+					setColor(leftOf(sib), black)
+					setColor(sib, red)
+					t.rotateRight(sib)
+					sib = rightOf(parentOf(x))
+				}
+				setColor(sib, colorOf(parentOf(x)))
+				setColor(parentOf(x), black)
+				setColor(rightOf(sib), black)
+				t.rotateLeft(parentOf(x))
+				x = t.root
+			}
+		} else {
+			sib := leftOf(parentOf(x))
+			if colorOf(sib) == red {
+				setColor(sib, black)
+				setColor(parentOf(x), red)
+				t.rotateRight(parentOf(x))
+				sib = leftOf(parentOf(x))
+			}
+			if colorOf(rightOf(sib)) == black && colorOf(leftOf(sib)) == black {
+				setColor(sib, red)
+				x = parentOf(x)
+			} else {
+				if colorOf(leftOf(sib)) == black {
+					setColor(rightOf(sib), black)
+					setColor(sib, red)
+					t.rotateLeft(sib)
+					sib = leftOf(parentOf(x))
+				}
+				setColor(sib, colorOf(parentOf(x)))
+				setColor(parentOf(x), black)
+				setColor(leftOf(sib), black)
+				t.rotateRight(parentOf(x))
+				x = t.root
+			}
+		}
+	}
+	setColor(x, black)
+}
+
+// treeIterator walks the nodes of a tree in ascending or descending order
+// mapping each node to the returned item, it stops before reaching end.
+type treeIterator[K any, V any, E any] struct {
+	tree         *rbTree[K, V]
+	next         *rbNode[K, V]
+	end          *rbNode[K, V]
+	lastReturned *rbNode[K, V]
+	index        int
+	descending   bool
+	expected     int
+	item         func(n *rbNode[K, V]) E
+}
+
+func newTreeIterator[K any, V any, E any](tree *rbTree[K, V], first, end *rbNode[K, V], descending bool, item func(n *rbNode[K, V]) E) *treeIterator[K, V, E] {
+	return &treeIterator[K, V, E]{tree: tree, next: first, end: end, descending: descending, expected: tree.mods, item: item}
+}
+
+func (it *treeIterator[K, V, E]) HasNext() bool {
+	return it.next != nil && it.next != it.end
+}
+
+func (it *treeIterator[K, V, E]) Next() E {
+	_, item := it.NextWithIndex()
+	return item
+}
+
+func (it *treeIterator[K, V, E]) NextWithIndex() (int, E) {
+	checkModifications(it.expected, it.tree.mods)
+	n := it.next
+	if it.descending {
+		it.next = predecessor(n)
+	} else {
+		it.next = successor(n)
+	}
+	it.lastReturned = n
+	index := it.index
+	it.index++
+	return index, it.item(n)
+}
+
+// Remove deletes the node returned by the last call to Next.
+func (it *treeIterator[K, V, E]) Remove() error {
+	if it.lastReturned == nil {
+		return ErrIllegalIteratorState
+	}
+	checkModifications(it.expected, it.tree.mods)
+	n := it.lastReturned
+	if !it.descending && n.left != nil && n.right != nil {
+		// deleteNode moves the successor into n, so n is the next node to visit
+		if it.end == it.next {
+			it.end = n
+		}
+		it.next = n
+	}
+	it.tree.deleteNode(n)
+	it.lastReturned = nil
+	it.index--
+	it.expected = it.tree.mods
+	return nil
+}
@@ -0,0 +1,66 @@
+package collection
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// checkRBTree verifies the red-black properties and returns the black height.
+func checkRBTree[K any, V any](t *testing.T, tree *rbTree[K, V], n *rbNode[K, V]) int {
+	if n == nil {
+		return 1
+	}
+	if n.color == red && (colorOf(n.left) == red || colorOf(n.right) == red) {
+		t.Fatalf("red node %v has a red child", n.key)
+	}
+	if n.left != nil && (n.left.parent != n || tree.compare(n.left.key, n.key) >= 0) {
+		t.Fatalf("left child of %v is misplaced", n.key)
+	}
+	if n.right != nil && (n.right.parent != n || tree.compare(n.right.key, n.key) <= 0) {
+		t.Fatalf("right child of %v is misplaced", n.key)
+	}
+	left := checkRBTree(t, tree, n.left)
+	right := checkRBTree(t, tree, n.right)
+	if left != right {
+		t.Fatalf("node %v has black height %d on the left and %d on the right", n.key, left, right)
+	}
+	if n.color == black {
+		return left + 1
+	}
+	return left
+}
+
+func TestRBTree_Invariants(t *testing.T) {
+	tree := newRBTree[int, int](NaturalOrder[int]())
+	want := make(map[int]int)
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 5000; i++ {
+		key := r.Intn(500)
+		if r.Intn(3) == 0 {
+			_, ok := tree.remove(key)
+			_, found := want[key]
+			if ok != found {
+				t.Fatalf("remove %d want %v got %v", key, found, ok)
+			}
+			delete(want, key)
+		} else {
+			tree.put(key, i)
+			want[key] = i
+		}
+		if colorOf(tree.root) != black {
+			t.Fatalf("root is not black")
+		}
+		checkRBTree(t, tree, tree.root)
+		if tree.size != len(want) {
+			t.Fatalf("size want %d got %d", len(want), tree.size)
+		}
+	}
+
+	prev := -1
+	for n := tree.first(); n != nil; n = successor(n) {
+		if n.key <= prev || want[n.key] != n.value {
+			t.Fatalf("in order visit found %d after %d", n.key, prev)
+		}
+		prev = n.key
+	}
+}
@@ -0,0 +1,99 @@
+package collection
+
+import "iter"
+
+// Seq turns an iterator into a sequence that pulls the items from it while
+// ranging, so it can be used with for range and the slices and maps packages.
+func Seq[E any](it Iterator[E]) iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for it.HasNext() {
+			if !yield(it.Next()) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 is like Seq but it yields the index of each item as well.
+func Seq2[E any](it Iterator[E]) iter.Seq2[int, E] {
+	return func(yield func(int, E) bool) {
+		for it.HasNext() {
+			if !yield(it.NextWithIndex()) {
+				return
+			}
+		}
+	}
+}
+
+// IteratorOf turns a sequence into an Iterator, stop must be called when
+// the iterator is not consumed until the end.
+func IteratorOf[E any](seq iter.Seq[E]) (Iterator[E], func()) {
+	next, stop := iter.Pull(seq)
+	return &pullIterator[E]{next: next}, stop
+}
+
+// FromSeq pushes the items of seq into c and returns it.
+func FromSeq[E any, C Collection[E]](c C, seq iter.Seq[E]) C {
+	for item := range seq {
+		c.Push(item)
+	}
+	return c
+}
+
+// Collect builds a Slice with the items of seq.
+func Collect[E any](seq iter.Seq[E]) *Slice[E] {
+	return FromSeq(NewSlice[E](), seq)
+}
+
+// CollectMap builds a HashMap with the pairs of seq.
+func CollectMap[K comparable, V any](seq iter.Seq2[K, V]) *HashMap[K, V] {
+	m := NewHashMap[K, V]()
+	for k, v := range seq {
+		m.Put(k, v)
+	}
+	return m
+}
+
+// enumerate yields the items of seq along with their position.
+func enumerate[E any](seq iter.Seq[E]) iter.Seq2[int, E] {
+	return func(yield func(int, E) bool) {
+		i := 0
+		for item := range seq {
+			if !yield(i, item) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+type pullIterator[E any] struct {
+	next    func() (E, bool)
+	item    E
+	fetched bool
+	ok      bool
+	index   int
+}
+
+func (it *pullIterator[E]) HasNext() bool {
+	if !it.fetched {
+		it.item, it.ok = it.next()
+		it.fetched = true
+	}
+	return it.ok
+}
+
+func (it *pullIterator[E]) Next() E {
+	_, item := it.NextWithIndex()
+	return item
+}
+
+func (it *pullIterator[E]) NextWithIndex() (int, E) {
+	if !it.HasNext() {
+		return it.index, *new(E)
+	}
+	it.fetched = false
+	index := it.index
+	it.index++
+	return index, it.item
+}
@@ -0,0 +1,92 @@
+package collection
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestAll(t *testing.T) {
+	useCases := []struct {
+		description string
+		items       []int
+		want        []int
+	}{
+		{description: "slice", items: slices.Collect(NewSlice(1, 2, 3).All()), want: []int{1, 2, 3}},
+		{description: "linked list", items: slices.Collect(NewLinkedList(1, 2, 3).All()), want: []int{1, 2, 3}},
+		{description: "hash set", items: slices.Sorted(NewHashSet(3, 1, 2).All()), want: []int{1, 2, 3}},
+		{description: "stack", items: slices.Collect(NewStack(1, 2, 3).All()), want: []int{1, 2, 3}},
+		{description: "queue", items: slices.Collect(NewQueue(1, 2, 3).All()), want: []int{1, 2, 3}},
+		{description: "deque", items: slices.Collect(NewDeque(1, 2, 3).All()), want: []int{1, 2, 3}},
+		{description: "tree set", items: slices.Collect(NewTreeSet(3, 1, 2).All()), want: []int{1, 2, 3}},
+		{description: "priority queue", items: slices.Sorted(NewPriorityQueue(3, 1, 2).All()), want: []int{1, 2, 3}},
+		{description: "empty slice", items: slices.Collect(NewSlice[int]().All()), want: nil},
+	}
+
+	for _, tt := range useCases {
+		if !reflect.DeepEqual(tt.items, tt.want) {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.want, tt.items)
+		}
+	}
+}
+
+func TestEnumerate(t *testing.T) {
+	var indexes, items []int
+	for i, item := range NewLinkedList(10, 20, 30, 40).Enumerate() {
+		if i == 3 {
+			break
+		}
+		indexes = append(indexes, i)
+		items = append(items, item)
+	}
+	if !reflect.DeepEqual(indexes, []int{0, 1, 2}) || !reflect.DeepEqual(items, []int{10, 20, 30}) {
+		t.Errorf("test: enumerate with break want {%v, %v} got {%v, %v}", []int{0, 1, 2}, []int{10, 20, 30}, indexes, items)
+	}
+}
+
+func TestMapAll(t *testing.T) {
+	hashMap := NewHashMap(NewEntry(1, "one"), NewEntry(2, "two"))
+	if result := maps.Collect(hashMap.All()); !reflect.DeepEqual(result, map[int]string{1: "one", 2: "two"}) {
+		t.Errorf("test: hash map all want %v got %v", map[int]string{1: "one", 2: "two"}, result)
+	}
+
+	var keys []int
+	for k := range NewTreeMap(NewEntry(2, "two"), NewEntry(1, "one")).All() {
+		keys = append(keys, k)
+	}
+	if !reflect.DeepEqual(keys, []int{1, 2}) {
+		t.Errorf("test: tree map all want %v got %v", []int{1, 2}, keys)
+	}
+}
+
+func TestSeq(t *testing.T) {
+	if result := slices.Collect(Seq(NewSlice(1, 2, 3).Iterator())); !reflect.DeepEqual(result, []int{1, 2, 3}) {
+		t.Errorf("test: seq of iterator want %v got %v", []int{1, 2, 3}, result)
+	}
+	if result := maps.Collect(Seq2(NewSlice("a", "b").Iterator())); !reflect.DeepEqual(result, map[int]string{0: "a", 1: "b"}) {
+		t.Errorf("test: seq2 of iterator want %v got %v", map[int]string{0: "a", 1: "b"}, result)
+	}
+
+	it, stop := IteratorOf(slices.Values([]int{1, 2, 3}))
+	defer stop()
+	var items []int
+	for it.HasNext() {
+		items = append(items, it.Next())
+	}
+	if !reflect.DeepEqual(items, []int{1, 2, 3}) || it.HasNext() {
+		t.Errorf("test: iterator of seq want %v got %v", []int{1, 2, 3}, items)
+	}
+}
+
+func TestCollect(t *testing.T) {
+	if result := Collect(slices.Values([]int{1, 2, 3})); result.String() != "[1, 2, 3]" {
+		t.Errorf("test: collect want %v got %v", "[1, 2, 3]", result)
+	}
+	if result := FromSeq(NewTreeSet[int](), slices.Values([]int{3, 1, 3})); result.String() != "[1, 3]" {
+		t.Errorf("test: from seq want %v got %v", "[1, 3]", result)
+	}
+	if result := CollectMap(maps.All(map[string]int{"a": 1})); result.Size() != 1 || !result.ContainsKey("a") {
+		t.Errorf("test: collect map want %v got %v", "{[[a, 1]]}", result)
+	}
+}
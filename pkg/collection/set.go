@@ -0,0 +1,77 @@
+package collection
+
+// Union returns a new Set with the items that are in a or in b.
+func Union[E comparable](a, b Set[E]) Set[E] {
+	result := NewHashSet[E]()
+	result.UnionUpdate(a)
+	result.UnionUpdate(b)
+	return result
+}
+
+// Intersection returns a new Set with the items that are both in a and in b,
+// it iterates over the smaller of the two sets.
+func Intersection[E comparable](a, b Set[E]) Set[E] {
+	if a.Size() > b.Size() {
+		a, b = b, a
+	}
+	result := NewHashSet[E]()
+	for it := a.Iterator(); it.HasNext(); {
+		item := it.Next()
+		if b.Contains(item) {
+			result.Push(item)
+		}
+	}
+	return result
+}
+
+// Difference returns a new Set with the items of a that are not in b.
+func Difference[E comparable](a, b Set[E]) Set[E] {
+	result := NewHashSet[E]()
+	result.UnionUpdate(a)
+	result.DifferenceUpdate(b)
+	return result
+}
+
+// SymmetricDifference returns a new Set with the items that are either in a or in b but not in both.
+func SymmetricDifference[E comparable](a, b Set[E]) Set[E] {
+	result := NewHashSet[E]()
+	result.UnionUpdate(a)
+	result.SymmetricDifferenceUpdate(b)
+	return result
+}
+
+// IsSubsetOf reports whether every item of a is in b.
+func IsSubsetOf[E comparable](a, b Set[E]) bool {
+	if a.Size() > b.Size() {
+		return false
+	}
+	for it := a.Iterator(); it.HasNext(); {
+		if !b.Contains(it.Next()) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSupersetOf reports whether every item of b is in a.
+func IsSupersetOf[E comparable](a, b Set[E]) bool {
+	return IsSubsetOf(b, a)
+}
+
+// IsDisjoint reports whether a and b have no items in common.
+func IsDisjoint[E comparable](a, b Set[E]) bool {
+	if a.Size() > b.Size() {
+		a, b = b, a
+	}
+	for it := a.Iterator(); it.HasNext(); {
+		if b.Contains(it.Next()) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal reports whether a and b contain the same items.
+func Equal[E comparable](a, b Set[E]) bool {
+	return a.Size() == b.Size() && IsSubsetOf(a, b)
+}
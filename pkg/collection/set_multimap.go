@@ -0,0 +1,14 @@
+package collection
+
+// SetMultiMap is a map from a key to a set of values, backed by a HashMap of
+// HashSet. Putting a pair already in the map does nothing and the values of
+// a key come in random order. It is not safe for concurrent use.
+type SetMultiMap[K comparable, V comparable] struct {
+	*multiMap[K, V, *HashSet[V]]
+}
+
+func NewSetMultiMap[K comparable, V comparable]() *SetMultiMap[K, V] {
+	return &SetMultiMap[K, V]{newMultiMap[K, V](func(K) *HashSet[V] {
+		return NewHashSet[V]()
+	})}
+}
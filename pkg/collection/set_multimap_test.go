@@ -0,0 +1,42 @@
+package collection
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSetMultiMap_Duplicates(t *testing.T) {
+	m := NewSetMultiMap[string, int]()
+	useCases := []struct {
+		description string
+		value       int
+		want        bool
+	}{
+		{description: "put a new pair", value: 1, want: true},
+		{description: "put the same pair", value: 1, want: false},
+		{description: "put another pair", value: 2, want: true},
+	}
+
+	for _, tt := range useCases {
+		if result := m.Put("a", tt.value); result != tt.want {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.want, result)
+		}
+	}
+	if m.Size() != 2 || m.PutAll("a", NewSlice(1, 2)) {
+		t.Errorf("test: put all of known pairs want no change got %v", m)
+	}
+	if result := multiMapContent(m, "a"); !slices.Equal(result, []int{1, 2}) {
+		t.Errorf("test: values want %v got %v", []int{1, 2}, result)
+	}
+}
+
+func TestSetMultiMap_GetIsSet(t *testing.T) {
+	m := NewSetMultiMap[string, int]()
+	m.Put("a", 1)
+	view := m.Get("a")
+	view.Push(1)
+	view.PushAll(NewSlice(1, 2, 2))
+	if view.Size() != 2 || m.Size() != 2 {
+		t.Errorf("test: view of a set want 2 values got %v", view)
+	}
+}
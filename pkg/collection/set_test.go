@@ -0,0 +1,53 @@
+package collection
+
+import "testing"
+
+func TestSet_Algebra(t *testing.T) {
+	a := NewHashSet(1, 2, 3, 4)
+	b := NewTreeSet(3, 4, 5)
+	useCases := []struct {
+		description string
+		result      Set[int]
+		want        Set[int]
+	}{
+		{description: "union", result: Union[int](a, b), want: NewHashSet(1, 2, 3, 4, 5)},
+		{description: "intersection", result: Intersection[int](a, b), want: NewHashSet(3, 4)},
+		{description: "intersection with empty set", result: Intersection[int](a, NewHashSet[int]()), want: NewHashSet[int]()},
+		{description: "difference", result: Difference[int](a, b), want: NewHashSet(1, 2)},
+		{description: "reversed difference", result: Difference[int](b, a), want: NewHashSet(5)},
+		{description: "symmetric difference", result: SymmetricDifference[int](a, b), want: NewHashSet(1, 2, 5)},
+	}
+
+	for _, tt := range useCases {
+		if !Equal(tt.result, tt.want) {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.want, tt.result)
+		}
+	}
+	if !Equal[int](a, NewHashSet(1, 2, 3, 4)) {
+		t.Errorf("test: operands must not change want %v got %v", NewHashSet(1, 2, 3, 4), a)
+	}
+}
+
+func TestSet_Predicates(t *testing.T) {
+	useCases := []struct {
+		description string
+		result      bool
+		want        bool
+	}{
+		{description: "subset", result: IsSubsetOf[int](NewHashSet(1, 2), NewHashSet(1, 2, 3)), want: true},
+		{description: "not subset", result: IsSubsetOf[int](NewHashSet(1, 4), NewHashSet(1, 2, 3)), want: false},
+		{description: "empty set is subset", result: IsSubsetOf[int](NewHashSet[int](), NewHashSet(1)), want: true},
+		{description: "superset", result: IsSupersetOf[int](NewHashSet(1, 2, 3), NewTreeSet(1, 2)), want: true},
+		{description: "not superset", result: IsSupersetOf[int](NewHashSet(1, 2), NewHashSet(1, 2, 3)), want: false},
+		{description: "disjoint", result: IsDisjoint[int](NewHashSet(1, 2), NewHashSet(3, 4, 5)), want: true},
+		{description: "not disjoint", result: IsDisjoint[int](NewHashSet(1, 2, 3), NewHashSet(3)), want: false},
+		{description: "equal", result: Equal[int](NewHashSet(1, 2), NewTreeSet(2, 1)), want: true},
+		{description: "not equal with same size", result: Equal[int](NewHashSet(1, 2), NewHashSet(1, 3)), want: false},
+	}
+
+	for _, tt := range useCases {
+		if tt.result != tt.want {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.want, tt.result)
+		}
+	}
+}
@@ -2,46 +2,203 @@ package collection
 
 import (
 	"fmt"
+	"iter"
 	"math"
-	"reflect"
 	"strings"
 )
 
+// sliceIterator walks the backing slice of a collection, it panics with
+// ErrConcurrentModification when mods no longer matches the expected count.
 type sliceIterator[E any] struct {
-	index int
-	slice []E
+	index    int
+	last     int
+	items    *[]E
+	mods     *int
+	expected int
+	remove   func(pos int)
+}
+
+func newSliceIterator[E any](items *[]E, mods *int, remove func(pos int)) *sliceIterator[E] {
+	return &sliceIterator[E]{last: -1, items: items, mods: mods, expected: *mods, remove: remove}
+}
+
+// newSnapshotIterator returns an iterator over a private copy of the items
+// of a collection, its Remove returns ErrUnsupportedOperation since removing
+// from the copy would leave the collection unchanged.
+func newSnapshotIterator[E any](items []E) *sliceIterator[E] {
+	return newSliceIterator(&items, new(int), nil)
 }
 
 func (it *sliceIterator[E]) HasNext() bool {
-	return it.index < len(it.slice)
+	return it.index < len(*it.items)
 }
 
 func (it *sliceIterator[E]) Next() E {
-	item := it.slice[it.index]
-	it.index += 1
+	_, item := it.NextWithIndex()
 	return item
 }
 
 func (it *sliceIterator[E]) NextWithIndex() (int, E) {
+	checkModifications(it.expected, *it.mods)
 	index := it.index
-	item := it.slice[index]
+	item := (*it.items)[index]
+	it.last = index
 	it.index += 1
 	return index, item
 }
 
+// Remove deletes the item returned by the last call to Next.
+func (it *sliceIterator[E]) Remove() error {
+	if it.remove == nil {
+		return ErrUnsupportedOperation
+	}
+	if it.last < 0 {
+		return ErrIllegalIteratorState
+	}
+	checkModifications(it.expected, *it.mods)
+	it.remove(it.last)
+	it.index = it.last
+	it.last = -1
+	it.expected = *it.mods
+	return nil
+}
+
+// sliceListIterator is the ListIterator of a Slice, cursor is the position
+// of the item returned by the next call to Next.
+type sliceListIterator[E any] struct {
+	slice    *Slice[E]
+	cursor   int
+	last     int
+	expected int
+}
+
+func (it *sliceListIterator[E]) HasNext() bool {
+	return it.cursor < it.slice.Size()
+}
+
+func (it *sliceListIterator[E]) Next() E {
+	_, item := it.NextWithIndex()
+	return item
+}
+
+func (it *sliceListIterator[E]) NextWithIndex() (int, E) {
+	checkModifications(it.expected, it.slice.mods)
+	index := it.cursor
+	it.last = index
+	it.cursor++
+	return index, it.slice.inner[index]
+}
+
+func (it *sliceListIterator[E]) HasPrevious() bool {
+	return it.cursor > 0
+}
+
+func (it *sliceListIterator[E]) Previous() E {
+	checkModifications(it.expected, it.slice.mods)
+	if !it.HasPrevious() {
+		panic(ErrIllegalIteratorState)
+	}
+	it.cursor--
+	it.last = it.cursor
+	return it.slice.inner[it.cursor]
+}
+
+// Remove deletes the item returned by the last call to Next or Previous.
+func (it *sliceListIterator[E]) Remove() error {
+	if it.last < 0 {
+		return ErrIllegalIteratorState
+	}
+	checkModifications(it.expected, it.slice.mods)
+	it.slice.DeleteAt(it.last)
+	if it.last < it.cursor {
+		it.cursor--
+	}
+	it.last = -1
+	it.expected = it.slice.mods
+	return nil
+}
+
+// Set replaces the item returned by the last call to Next or Previous.
+func (it *sliceListIterator[E]) Set(item E) error {
+	if it.last < 0 {
+		return ErrIllegalIteratorState
+	}
+	checkModifications(it.expected, it.slice.mods)
+	it.slice.inner[it.last] = item
+	return nil
+}
+
+// Add inserts item before the cursor, a following call to Next is not affected.
+func (it *sliceListIterator[E]) Add(item E) {
+	checkModifications(it.expected, it.slice.mods)
+	it.slice.PushAt(item, it.cursor)
+	it.cursor++
+	it.last = -1
+	it.expected = it.slice.mods
+}
+
 type Slice[E any] struct {
 	inner []E
+	equal EqualFunc[E]
+	mods  int
 }
 
 func NewSlice[E any](items ...E) *Slice[E] {
-	return &Slice[E]{items}
+	return &Slice[E]{inner: items}
+}
+
+// NewSliceWith creates a slice configured with the given options, it holds
+// the items given by WithItems.
+func NewSliceWith[E any](opts ...Option[E]) *Slice[E] {
+	o := newOptions(opts...)
+	return &Slice[E]{inner: o.items, equal: o.equal}
+}
+
+func (s *Slice[E]) eq() EqualFunc[E] {
+	if s.equal == nil {
+		return DefaultEqual[E]()
+	}
+	return s.equal
 }
 
 func (s *Slice[E]) Iterator() Iterator[E] {
 	if s.Empty() {
 		return &emptyListIterator[E]{}
 	}
-	return &sliceIterator[E]{0, s.inner}
+	return newSliceIterator(&s.inner, &s.mods, func(pos int) {
+		s.DeleteAt(pos)
+	})
+}
+
+// ListIterator returns a ListIterator whose first call to Next returns the
+// item at startPos, but if startPos is not between 0 and the size of the
+// slice well this method return ErrPositionNegative or ErrIndexOutOfBound error.
+func (s *Slice[E]) ListIterator(startPos int) (ListIterator[E], error) {
+	if startPos < 0 {
+		return nil, ErrPositionNegative
+	}
+	if startPos > s.Size() {
+		return nil, ErrIndexOutOfBound{startPos, s.Size()}
+	}
+	return &sliceListIterator[E]{slice: s, cursor: startPos, last: -1, expected: s.mods}, nil
+}
+
+// All returns a sequence over the items of the slice.
+func (s *Slice[E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		mods := s.mods
+		for _, item := range s.inner {
+			if !yield(item) {
+				return
+			}
+			checkModifications(mods, s.mods)
+		}
+	}
+}
+
+// Enumerate returns a sequence over the items of the slice along with their position.
+func (s *Slice[E]) Enumerate() iter.Seq2[int, E] {
+	return enumerate(s.All())
 }
 
 func (s *Slice[E]) Empty() bool {
@@ -88,6 +245,7 @@ func (s *Slice[E]) PushFront(item E) {
 
 func (s *Slice[E]) PushAt(item E, pos int) {
 	s.inner = insert(s.inner, item, pos)
+	s.mods++
 }
 
 func insert[E any](slice []E, item E, pos int) []E {
@@ -137,12 +295,14 @@ func (s *Slice[E]) DeleteAt(pos int) error {
 		return ErrEmptyCollection
 	}
 	s.inner = append(s.inner[:pos], s.inner[pos+1:]...)
+	s.mods++
 	return nil
 }
 
 func (s *Slice[E]) Contains(item E) bool {
+	eq := s.eq()
 	for _, elem := range s.inner {
-		if reflect.DeepEqual(elem, item) {
+		if eq(elem, item) {
 			return true
 		}
 	}
@@ -150,8 +310,9 @@ func (s *Slice[E]) Contains(item E) bool {
 }
 
 func (s *Slice[E]) Index(item E) (int, error) {
+	eq := s.eq()
 	for i, x := range s.inner {
-		if reflect.DeepEqual(x, item) {
+		if eq(x, item) {
 			return i, nil
 		}
 	}
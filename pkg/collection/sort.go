@@ -0,0 +1,358 @@
+package collection
+
+import (
+	"math/rand/v2"
+	"slices"
+)
+
+// Sorting, searching and reordering of lists. Slice and LinkedList have
+// dedicated methods, the package functions accept any List and use them when
+// they exist, otherwise they go through GetAt and a ListIterator. Orders are
+// given as a three-way Comparator rather than a less function, so that the
+// same order sorts a list, searches it with BinarySearch and builds a
+// TreeSet, TreeMap or PriorityQueue. A less function is turned into a
+// Comparator by returning -1 when less(a, b), 1 when less(b, a) and 0
+// otherwise. Every method reordering the items counts as a modification for
+// the iterators, unless the list has less than two items or the rotation
+// distance is a multiple of the size, which leave the list as it is.
+
+// Sort sorts the slice in ascending order of compare with pdqsort, it is not
+// stable. It takes a Comparator instead of a less function, see above.
+func (s *Slice[E]) Sort(compare Comparator[E]) {
+	if len(s.inner) < 2 {
+		return
+	}
+	slices.SortFunc(s.inner, compare)
+	s.mods++
+}
+
+// SortStable sorts the slice in ascending order of compare keeping the order
+// of equal items. It takes a Comparator instead of a less function.
+func (s *Slice[E]) SortStable(compare Comparator[E]) {
+	if len(s.inner) < 2 {
+		return
+	}
+	slices.SortStableFunc(s.inner, compare)
+	s.mods++
+}
+
+// IsSorted reports whether the slice is in ascending order of compare.
+func (s *Slice[E]) IsSorted(compare Comparator[E]) bool {
+	return slices.IsSortedFunc(s.inner, compare)
+}
+
+// BinarySearch searches item in the slice sorted in ascending order of
+// compare and returns the position where it is found or where it would be
+// inserted, along with whether it was found.
+func (s *Slice[E]) BinarySearch(item E, compare Comparator[E]) (int, bool) {
+	return slices.BinarySearchFunc(s.inner, item, compare)
+}
+
+func (s *Slice[E]) Reverse() {
+	if len(s.inner) < 2 {
+		return
+	}
+	slices.Reverse(s.inner)
+	s.mods++
+}
+
+// Shuffle puts the items of the slice in a random order drawn from rnd.
+func (s *Slice[E]) Shuffle(rnd *rand.Rand) {
+	if len(s.inner) < 2 {
+		return
+	}
+	rnd.Shuffle(len(s.inner), func(i, j int) {
+		s.inner[i], s.inner[j] = s.inner[j], s.inner[i]
+	})
+	s.mods++
+}
+
+// Rotate moves the item at position i to position (i + distance) mod size,
+// a negative distance rotates to the front.
+func (s *Slice[E]) Rotate(distance int) {
+	k := rotation(distance, len(s.inner))
+	if k == 0 {
+		return
+	}
+	slices.Reverse(s.inner)
+	slices.Reverse(s.inner[:k])
+	slices.Reverse(s.inner[k:])
+	s.mods++
+}
+
+// rotation normalizes distance to [0, size).
+func rotation(distance, size int) int {
+	if size == 0 {
+		return 0
+	}
+	return (distance%size + size) % size
+}
+
+// Sort sorts the list in ascending order of compare with a merge sort that
+// relinks the nodes, it takes O(n log n) and no extra memory and it is stable.
+// It takes a Comparator instead of a less function.
+func (l *LinkedList[E]) Sort(compare Comparator[E]) {
+	l.mergeSort(compare)
+}
+
+// SortStable is the same as Sort which is already stable, it takes a
+// Comparator instead of a less function.
+func (l *LinkedList[E]) SortStable(compare Comparator[E]) {
+	l.mergeSort(compare)
+}
+
+// mergeSort is a bottom up merge sort over the next links, runs of width
+// 1, 2, 4, ... are merged until a single run is left. The prev links are
+// rebuilt while merging.
+func (l *LinkedList[E]) mergeSort(compare Comparator[E]) {
+	if l.size < 2 {
+		return
+	}
+	l.mods++
+	head := l.head
+	for width := 1; ; width *= 2 {
+		var newHead, tail *node[E]
+		merges := 0
+		for p := head; p != nil; {
+			merges++
+			q, pSize := p, 0
+			for pSize < width && q != nil {
+				q = q.next
+				pSize++
+			}
+			qSize := width
+			for pSize > 0 || (qSize > 0 && q != nil) {
+				var n *node[E]
+				switch {
+				case pSize == 0:
+					n, q, qSize = q, q.next, qSize-1
+				case qSize == 0 || q == nil:
+					n, p, pSize = p, p.next, pSize-1
+				case compare(p.value, q.value) <= 0:
+					n, p, pSize = p, p.next, pSize-1
+				default:
+					n, q, qSize = q, q.next, qSize-1
+				}
+				if tail == nil {
+					newHead = n
+				} else {
+					tail.next = n
+				}
+				n.prev = tail
+				tail = n
+			}
+			p = q
+		}
+		tail.next = nil
+		head = newHead
+		if merges == 1 {
+			l.head, l.tail = head, tail
+			return
+		}
+	}
+}
+
+// IsSorted reports whether the list is in ascending order of compare.
+func (l *LinkedList[E]) IsSorted(compare Comparator[E]) bool {
+	for n := l.head; n != nil && n.next != nil; n = n.next {
+		if compare(n.value, n.next.value) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// BinarySearch searches item in the list sorted in ascending order of
+// compare and returns the position where it is found or where it would be
+// inserted, along with whether it was found. It makes O(log n) comparisons
+// but walks O(n) nodes.
+func (l *LinkedList[E]) BinarySearch(item E, compare Comparator[E]) (int, bool) {
+	lo, hi := 0, l.size
+	n, pos := l.head, 0
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		for ; pos < mid; pos++ {
+			n = n.next
+		}
+		for ; pos > mid; pos-- {
+			n = n.prev
+		}
+		if compare(n.value, item) < 0 {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	if lo == l.size {
+		return lo, false
+	}
+	for ; pos < lo; pos++ {
+		n = n.next
+	}
+	return lo, compare(n.value, item) == 0
+}
+
+func (l *LinkedList[E]) Reverse() {
+	if l.size < 2 {
+		return
+	}
+	for n := l.head; n != nil; n = n.prev {
+		n.prev, n.next = n.next, n.prev
+	}
+	l.head, l.tail = l.tail, l.head
+	l.mods++
+}
+
+// Shuffle puts the items of the list in a random order drawn from rnd by relinking the nodes.
+func (l *LinkedList[E]) Shuffle(rnd *rand.Rand) {
+	if l.size < 2 {
+		return
+	}
+	l.mods++
+	nodes := make([]*node[E], 0, l.size)
+	for n := l.head; n != nil; n = n.next {
+		nodes = append(nodes, n)
+	}
+	rnd.Shuffle(len(nodes), func(i, j int) {
+		nodes[i], nodes[j] = nodes[j], nodes[i]
+	})
+	var prev *node[E]
+	for _, n := range nodes {
+		n.prev = prev
+		if prev != nil {
+			prev.next = n
+		}
+		prev = n
+	}
+	prev.next = nil
+	l.head, l.tail = nodes[0], prev
+}
+
+// Rotate moves the item at position i to position (i + distance) mod size,
+// a negative distance rotates to the front. It relinks the ends of the list.
+func (l *LinkedList[E]) Rotate(distance int) {
+	k := rotation(distance, l.size)
+	if k == 0 {
+		return
+	}
+	newHead, _ := l.findNode(l.size - k)
+	l.tail.next, l.head.prev = l.head, l.tail
+	l.head, l.tail = newHead, newHead.prev
+	l.head.prev, l.tail.next = nil, nil
+	l.mods++
+}
+
+// listItems copies the items of l into a slice.
+func listItems[E any](l List[E]) []E {
+	items := make([]E, 0, l.Size())
+	for it := l.Iterator(); it.HasNext(); {
+		items = append(items, it.Next())
+	}
+	return items
+}
+
+// setItems overwrites the items of l with items which has the same size.
+func setItems[E any](l List[E], items []E) {
+	it, _ := l.ListIterator(0)
+	for _, item := range items {
+		it.Next()
+		it.Set(item)
+	}
+}
+
+// Sort sorts l in ascending order of compare, it is not stable. It takes a
+// Comparator instead of a less function, see the top of this file.
+func Sort[E any](l List[E], compare Comparator[E]) {
+	if s, ok := l.(interface{ Sort(Comparator[E]) }); ok {
+		s.Sort(compare)
+		return
+	}
+	items := listItems(l)
+	slices.SortFunc(items, compare)
+	setItems(l, items)
+}
+
+// SortStable sorts l in ascending order of compare keeping the order of
+// equal items. It takes a Comparator instead of a less function.
+func SortStable[E any](l List[E], compare Comparator[E]) {
+	if s, ok := l.(interface{ SortStable(Comparator[E]) }); ok {
+		s.SortStable(compare)
+		return
+	}
+	items := listItems(l)
+	slices.SortStableFunc(items, compare)
+	setItems(l, items)
+}
+
+// IsSorted reports whether l is in ascending order of compare.
+func IsSorted[E any](l List[E], compare Comparator[E]) bool {
+	if s, ok := l.(interface{ IsSorted(Comparator[E]) bool }); ok {
+		return s.IsSorted(compare)
+	}
+	return slices.IsSortedFunc(listItems(l), compare)
+}
+
+// BinarySearch searches item in l sorted in ascending order of compare and
+// returns the position where it is found or where it would be inserted,
+// along with whether it was found. Lists without their own BinarySearch are
+// searched with O(log n) calls to GetAt.
+func BinarySearch[E any](l List[E], item E, compare Comparator[E]) (int, bool) {
+	if s, ok := l.(interface {
+		BinarySearch(E, Comparator[E]) (int, bool)
+	}); ok {
+		return s.BinarySearch(item, compare)
+	}
+	size := l.Size()
+	lo, hi := 0, size
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		x, _ := l.GetAt(mid)
+		if compare(x, item) < 0 {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	if lo == size {
+		return lo, false
+	}
+	x, _ := l.GetAt(lo)
+	return lo, compare(x, item) == 0
+}
+
+// Reverse reverses the order of the items of l.
+func Reverse[E any](l List[E]) {
+	if s, ok := l.(interface{ Reverse() }); ok {
+		s.Reverse()
+		return
+	}
+	items := listItems(l)
+	slices.Reverse(items)
+	setItems(l, items)
+}
+
+// Shuffle puts the items of l in a random order drawn from rnd.
+func Shuffle[E any](l List[E], rnd *rand.Rand) {
+	if s, ok := l.(interface{ Shuffle(*rand.Rand) }); ok {
+		s.Shuffle(rnd)
+		return
+	}
+	items := listItems(l)
+	rnd.Shuffle(len(items), func(i, j int) {
+		items[i], items[j] = items[j], items[i]
+	})
+	setItems(l, items)
+}
+
+// Rotate moves the item of l at position i to position (i + distance) mod
+// size, a negative distance rotates to the front.
+func Rotate[E any](l List[E], distance int) {
+	if s, ok := l.(interface{ Rotate(int) }); ok {
+		s.Rotate(distance)
+		return
+	}
+	items := listItems(l)
+	k := rotation(distance, len(items))
+	items = append(items[len(items)-k:], items[:len(items)-k]...)
+	setItems(l, items)
+}
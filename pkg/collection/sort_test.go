@@ -0,0 +1,279 @@
+package collection
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// sortLists builds every list kind from items, the synchronized list has
+// none of the methods so it exercises the generic code of the package functions.
+var sortLists = []struct {
+	name string
+	new  func(items []int) List[int]
+}{
+	{name: "slice", new: func(items []int) List[int] { return NewSlice(slices.Clone(items)...) }},
+	{name: "linked list", new: func(items []int) List[int] { return NewLinkedList(items...) }},
+	{name: "synchronized list", new: func(items []int) List[int] { return SynchronizedList[int](NewSlice(slices.Clone(items)...)) }},
+}
+
+func sortedItems(l List[int]) []int {
+	items := slices.Collect(Seq(l.Iterator()))
+	// also walk backward to check the links of linked lists
+	it, _ := l.ListIterator(l.Size())
+	for i := len(items) - 1; i >= 0; i-- {
+		if !it.HasPrevious() || it.Previous() != items[i] {
+			return nil
+		}
+	}
+	if items == nil {
+		return []int{}
+	}
+	return items
+}
+
+func TestSort(t *testing.T) {
+	useCases := []struct {
+		description string
+		items       []int
+		want        []int
+	}{
+		{description: "empty list", items: []int{}, want: []int{}},
+		{description: "one item", items: []int{1}, want: []int{1}},
+		{description: "unsorted items", items: []int{5, 2, 8, 1, 9, 3}, want: []int{1, 2, 3, 5, 8, 9}},
+		{description: "duplicates", items: []int{3, 1, 3, 2, 1}, want: []int{1, 1, 2, 3, 3}},
+		{description: "reversed items", items: []int{5, 4, 3, 2, 1}, want: []int{1, 2, 3, 4, 5}},
+	}
+
+	for _, f := range sortLists {
+		for _, tt := range useCases {
+			l := f.new(tt.items)
+			Sort(l, NaturalOrder[int]())
+			if result := sortedItems(l); !slices.Equal(result, tt.want) || !IsSorted(l, NaturalOrder[int]()) {
+				t.Errorf("test: %s %s want %v got %v", f.name, tt.description, tt.want, result)
+			}
+		}
+	}
+}
+
+func TestSortStable(t *testing.T) {
+	// items are compared by their tens only, the units tell the original order
+	byTens := func(a, b int) int {
+		return cmp.Compare(a/10, b/10)
+	}
+	items := []int{31, 10, 32, 20, 11, 33, 21, 12}
+	want := []int{10, 11, 12, 20, 21, 31, 32, 33}
+
+	for _, f := range sortLists {
+		l := f.new(items)
+		SortStable(l, byTens)
+		if result := sortedItems(l); !slices.Equal(result, want) {
+			t.Errorf("test: %s want %v got %v", f.name, want, result)
+		}
+	}
+
+	r := rand.New(rand.NewPCG(1, 2))
+	random := make([]int, 1000)
+	for i := range random {
+		random[i] = r.IntN(100)*10 + i%10
+	}
+	wantRandom := slices.Clone(random)
+	slices.SortStableFunc(wantRandom, byTens)
+	for _, f := range sortLists {
+		l := f.new(random)
+		SortStable(l, byTens)
+		if result := sortedItems(l); !slices.Equal(result, wantRandom) {
+			t.Errorf("test: %s random items not stably sorted", f.name)
+		}
+	}
+}
+
+func TestIsSorted(t *testing.T) {
+	useCases := []struct {
+		description string
+		items       []int
+		compare     Comparator[int]
+		want        bool
+	}{
+		{description: "empty list", items: []int{}, compare: NaturalOrder[int](), want: true},
+		{description: "sorted items", items: []int{1, 2, 2, 3}, compare: NaturalOrder[int](), want: true},
+		{description: "unsorted items", items: []int{1, 3, 2}, compare: NaturalOrder[int]()},
+		{description: "reverse order", items: []int{3, 2, 1}, compare: ReverseOrder(NaturalOrder[int]()), want: true},
+	}
+
+	for _, f := range sortLists {
+		for _, tt := range useCases {
+			if result := IsSorted(f.new(tt.items), tt.compare); result != tt.want {
+				t.Errorf("test: %s %s want %v got %v", f.name, tt.description, tt.want, result)
+			}
+		}
+	}
+}
+
+func TestBinarySearch(t *testing.T) {
+	useCases := []struct {
+		description string
+		items       []int
+		item        int
+		pos         int
+		found       bool
+	}{
+		{description: "empty list", items: []int{}, item: 1, pos: 0},
+		{description: "first item", items: []int{1, 3, 5, 7}, item: 1, pos: 0, found: true},
+		{description: "last item", items: []int{1, 3, 5, 7}, item: 7, pos: 3, found: true},
+		{description: "middle item", items: []int{1, 3, 5, 7, 9}, item: 5, pos: 2, found: true},
+		{description: "first of duplicates", items: []int{1, 3, 3, 3, 7}, item: 3, pos: 1, found: true},
+		{description: "before every item", items: []int{1, 3, 5}, item: 0, pos: 0},
+		{description: "between items", items: []int{1, 3, 5}, item: 4, pos: 2},
+		{description: "after every item", items: []int{1, 3, 5}, item: 6, pos: 3},
+	}
+
+	for _, f := range sortLists {
+		for _, tt := range useCases {
+			pos, found := BinarySearch(f.new(tt.items), tt.item, NaturalOrder[int]())
+			if pos != tt.pos || found != tt.found {
+				t.Errorf("test: %s %s want (%v, %v) got (%v, %v)", f.name, tt.description, tt.pos, tt.found, pos, found)
+			}
+		}
+	}
+}
+
+func TestReverse(t *testing.T) {
+	useCases := []struct {
+		description string
+		items       []int
+		want        []int
+	}{
+		{description: "empty list", items: []int{}, want: []int{}},
+		{description: "one item", items: []int{1}, want: []int{1}},
+		{description: "odd size", items: []int{1, 2, 3}, want: []int{3, 2, 1}},
+		{description: "even size", items: []int{1, 2, 3, 4}, want: []int{4, 3, 2, 1}},
+	}
+
+	for _, f := range sortLists {
+		for _, tt := range useCases {
+			l := f.new(tt.items)
+			Reverse(l)
+			if result := sortedItems(l); !slices.Equal(result, tt.want) {
+				t.Errorf("test: %s %s want %v got %v", f.name, tt.description, tt.want, result)
+			}
+		}
+	}
+}
+
+func TestRotate(t *testing.T) {
+	useCases := []struct {
+		description string
+		items       []int
+		distance    int
+		want        []int
+	}{
+		{description: "empty list", items: []int{}, distance: 2, want: []int{}},
+		{description: "no rotation", items: []int{1, 2, 3}, distance: 0, want: []int{1, 2, 3}},
+		{description: "rotate to the back", items: []int{1, 2, 3, 4, 5}, distance: 2, want: []int{4, 5, 1, 2, 3}},
+		{description: "rotate to the front", items: []int{1, 2, 3, 4, 5}, distance: -1, want: []int{2, 3, 4, 5, 1}},
+		{description: "distance above size", items: []int{1, 2, 3}, distance: 7, want: []int{3, 1, 2}},
+		{description: "full turn", items: []int{1, 2, 3}, distance: 3, want: []int{1, 2, 3}},
+	}
+
+	for _, f := range sortLists {
+		for _, tt := range useCases {
+			l := f.new(tt.items)
+			Rotate(l, tt.distance)
+			if result := sortedItems(l); !slices.Equal(result, tt.want) {
+				t.Errorf("test: %s %s want %v got %v", f.name, tt.description, tt.want, result)
+			}
+		}
+	}
+}
+
+func TestShuffle(t *testing.T) {
+	items := rangeItems(0, 100)
+	for _, f := range sortLists {
+		l := f.new(items)
+		Shuffle(l, rand.New(rand.NewPCG(1, 2)))
+		result := sortedItems(l)
+		if slices.Equal(result, items) {
+			t.Errorf("test: %s want items shuffled", f.name)
+		}
+		slices.Sort(result)
+		if !slices.Equal(result, items) {
+			t.Errorf("test: %s want the same items got %v", f.name, result)
+		}
+
+		again := f.new(items)
+		Shuffle(again, rand.New(rand.NewPCG(1, 2)))
+		if !slices.Equal(sortedItems(again), sortedItems(l)) {
+			t.Errorf("test: %s same seed want same order", f.name)
+		}
+	}
+}
+
+func TestSort_ConcurrentModification(t *testing.T) {
+	lists := []struct {
+		name string
+		list interface {
+			List[int]
+			Sort(Comparator[int])
+		}
+	}{
+		{name: "slice", list: NewSlice(3, 1, 2)},
+		{name: "linked list", list: NewLinkedList(3, 1, 2)},
+	}
+
+	for _, tt := range lists {
+		err := modificationPanic(func() {
+			for it := tt.list.Iterator(); it.HasNext(); {
+				it.Next()
+				tt.list.Sort(NaturalOrder[int]())
+			}
+		})
+		if _, ok := err.(ErrConcurrentModification); !ok {
+			t.Errorf("test: %s sort while iterating want %T got %v", tt.name, ErrConcurrentModification{}, err)
+		}
+	}
+}
+
+func TestSort_NoOpKeepsIterators(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 2))
+	useCases := []struct {
+		description string
+		change      func(l List[int])
+	}{
+		{description: "sort", change: func(l List[int]) { Sort(l, NaturalOrder[int]()) }},
+		{description: "sort stable", change: func(l List[int]) { SortStable(l, NaturalOrder[int]()) }},
+		{description: "reverse", change: func(l List[int]) { Reverse(l) }},
+		{description: "shuffle", change: func(l List[int]) { Shuffle(l, rnd) }},
+		{description: "rotate", change: func(l List[int]) { Rotate(l, 3) }},
+	}
+
+	for _, f := range sortLists[:2] {
+		for _, tt := range useCases {
+			l := f.new([]int{1})
+			err := modificationPanic(func() {
+				it := l.Iterator()
+				tt.change(l)
+				it.Next()
+			})
+			if err != nil {
+				t.Errorf("test: %s %s of a single item want %v got %v", f.name, tt.description, nil, err)
+			}
+		}
+	}
+}
+
+func BenchmarkSort(b *testing.B) {
+	r := rand.New(rand.NewPCG(1, 2))
+	items := make([]int, 10000)
+	for i := range items {
+		items[i] = r.Int()
+	}
+	for _, f := range sortLists[:2] {
+		b.Run(f.name, func(b *testing.B) {
+			for b.Loop() {
+				Sort(f.new(items), NaturalOrder[int]())
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package collection
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 )
 
@@ -19,6 +20,22 @@ func NewStack[E any](items ...E) *Stack[E] {
 	return stack
 }
 
+// All returns a sequence over the items of the stack from the bottom to the top.
+func (s *Stack[E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for _, item := range s.items {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate returns a sequence over the items of the stack from the bottom to the top along with their position.
+func (s *Stack[E]) Enumerate() iter.Seq2[int, E] {
+	return enumerate(s.All())
+}
+
 // Size returns the number of items in the stack.
 func (s *Stack[E]) Size() int {
 	return len(s.items)
@@ -0,0 +1,434 @@
+package collection
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// viewParent is a list a subList can be taken from, Slice, LinkedList and subList itself.
+type viewParent[E any] interface {
+	List[E]
+	SubList(from, to int) List[E]
+	RemoveRange(from, to int) error
+	InsertAll(pos int, items Iterable[E]) error
+	modCount() int
+	eq() EqualFunc[E]
+}
+
+// checkRange returns an error unless 0 <= from <= to <= size.
+func checkRange(from, to, size int) error {
+	if from < 0 || to < 0 {
+		return ErrPositionNegative
+	}
+	if to > size {
+		return ErrIndexOutOfBound{to, size}
+	}
+	if from > to {
+		return ErrIndexOutOfBound{from, to}
+	}
+	return nil
+}
+
+// checkInsert returns an error unless 0 <= pos <= size.
+func checkInsert(pos, size int) error {
+	if pos < 0 {
+		return ErrPositionNegative
+	}
+	if pos > size {
+		return ErrIndexOutOfBound{pos, size}
+	}
+	return nil
+}
+
+// iterableItems copies the items of items into a slice, so that a list can insert itself.
+func iterableItems[E any](items Iterable[E]) []E {
+	var result []E
+	for it := items.Iterator(); it.HasNext(); {
+		result = append(result, it.Next())
+	}
+	return result
+}
+
+func (s *Slice[E]) modCount() int {
+	return s.mods
+}
+
+// SubList returns a live view of the items of the slice from position from
+// included to position to excluded, see subList. Like slicing, if the
+// positions are not 0 <= from <= to <= size well this method panics with
+// ErrPositionNegative or ErrIndexOutOfBound error.
+func (s *Slice[E]) SubList(from, to int) List[E] {
+	return newSubList[E](s, from, to)
+}
+
+// RemoveRange deletes the items from position from included to position to
+// excluded, but if the positions are not 0 <= from <= to <= size well this
+// method return ErrPositionNegative or ErrIndexOutOfBound error.
+func (s *Slice[E]) RemoveRange(from, to int) error {
+	if err := checkRange(from, to, s.Size()); err != nil {
+		return err
+	}
+	if from == to {
+		return nil
+	}
+	s.inner = slices.Delete(s.inner, from, to)
+	s.mods++
+	return nil
+}
+
+// InsertAll inserts the items of items at position pos in their iteration
+// order, but if pos is not between 0 and the size of the slice well this
+// method return ErrPositionNegative or ErrIndexOutOfBound error.
+func (s *Slice[E]) InsertAll(pos int, items Iterable[E]) error {
+	if err := checkInsert(pos, s.Size()); err != nil {
+		return err
+	}
+	inserted := iterableItems(items)
+	if len(inserted) == 0 {
+		return nil
+	}
+	s.inner = slices.Insert(s.inner, pos, inserted...)
+	s.mods++
+	return nil
+}
+
+func (l *LinkedList[E]) modCount() int {
+	return l.mods
+}
+
+// SubList returns a live view of the items of the list from position from
+// included to position to excluded, see subList. Like slicing, if the
+// positions are not 0 <= from <= to <= size well this method panics with
+// ErrPositionNegative or ErrIndexOutOfBound error.
+func (l *LinkedList[E]) SubList(from, to int) List[E] {
+	return newSubList[E](l, from, to)
+}
+
+// RemoveRange deletes the items from position from included to position to
+// excluded, but if the positions are not 0 <= from <= to <= size well this
+// method return ErrPositionNegative or ErrIndexOutOfBound error.
+func (l *LinkedList[E]) RemoveRange(from, to int) error {
+	if err := checkRange(from, to, l.size); err != nil {
+		return err
+	}
+	if from == to {
+		return nil
+	}
+	n, _ := l.findNode(from)
+	for i := from; i < to; i++ {
+		next := n.next
+		l.unlink(n)
+		n = next
+	}
+	return nil
+}
+
+// InsertAll inserts the items of items at position pos in their iteration
+// order, but if pos is not between 0 and the size of the list well this
+// method return ErrPositionNegative or ErrIndexOutOfBound error.
+func (l *LinkedList[E]) InsertAll(pos int, items Iterable[E]) error {
+	if err := checkInsert(pos, l.size); err != nil {
+		return err
+	}
+	var at *node[E]
+	if pos < l.size {
+		at, _ = l.findNode(pos)
+	}
+	for _, item := range iterableItems(items) {
+		l.linkBefore(item, at)
+	}
+	return nil
+}
+
+// subList is a view of a range of its parent list. Reads and Set go through
+// to the parent, structural changes made through the view, or through its
+// iterators, are applied to the parent and the view follows them. Any other
+// structural change of the parent invalidates the view, which then panics
+// with ErrConcurrentModification like an iterator would.
+type subList[E any] struct {
+	parent   viewParent[E]
+	offset   int
+	size     int
+	expected int
+}
+
+// newSubList panics when the range is not inside the parent.
+func newSubList[E any](parent viewParent[E], from, to int) *subList[E] {
+	if err := checkRange(from, to, parent.Size()); err != nil {
+		panic(err)
+	}
+	return &subList[E]{parent: parent, offset: from, size: to - from, expected: parent.modCount()}
+}
+
+// check panics when the parent has been changed behind the back of the view.
+func (v *subList[E]) check() {
+	checkModifications(v.expected, v.parent.modCount())
+}
+
+// changed records a structural change made through the view.
+func (v *subList[E]) changed(delta int) {
+	v.size += delta
+	v.expected = v.parent.modCount()
+}
+
+func (v *subList[E]) modCount() int {
+	v.check()
+	return v.expected
+}
+
+func (v *subList[E]) eq() EqualFunc[E] {
+	return v.parent.eq()
+}
+
+func (v *subList[E]) checkPosition(pos int) error {
+	if v.size == 0 {
+		return ErrEmptyCollection
+	}
+	if pos < 0 {
+		return ErrPositionNegative
+	}
+	if pos >= v.size {
+		return ErrIndexOutOfBound{pos, v.size}
+	}
+	return nil
+}
+
+func (v *subList[E]) Iterator() Iterator[E] {
+	it, _ := v.ListIterator(0)
+	return it
+}
+
+func (v *subList[E]) ListIterator(startPos int) (ListIterator[E], error) {
+	v.check()
+	if err := checkInsert(startPos, v.size); err != nil {
+		return nil, err
+	}
+	it, err := v.parent.ListIterator(v.offset + startPos)
+	if err != nil {
+		return nil, err
+	}
+	return &subListIterator[E]{view: v, it: it, cursor: startPos, last: -1}, nil
+}
+
+// All returns a sequence over the items of the view.
+func (v *subList[E]) All() iter.Seq[E] {
+	return Seq(v.Iterator())
+}
+
+func (v *subList[E]) Empty() bool {
+	return v.Size() == 0
+}
+
+func (v *subList[E]) Size() int {
+	v.check()
+	return v.size
+}
+
+func (v *subList[E]) Back() (E, error) {
+	return v.GetAt(v.Size() - 1)
+}
+
+func (v *subList[E]) Front() (E, error) {
+	return v.GetAt(0)
+}
+
+func (v *subList[E]) GetAt(pos int) (E, error) {
+	v.check()
+	if err := v.checkPosition(pos); err != nil {
+		return *new(E), err
+	}
+	return v.parent.GetAt(v.offset + pos)
+}
+
+func (v *subList[E]) Set(item E, pos int) error {
+	v.check()
+	if err := v.checkPosition(pos); err != nil {
+		return err
+	}
+	return v.parent.Set(item, v.offset+pos)
+}
+
+func (v *subList[E]) Push(item E) {
+	v.PushBack(item)
+}
+
+func (v *subList[E]) PushBack(item E) {
+	v.PushAt(item, v.Size())
+}
+
+func (v *subList[E]) PushFront(item E) {
+	v.PushAt(item, 0)
+}
+
+// PushAt inserts item at pos in the view, nothing happens when pos is not between 0 and the size of the view.
+func (v *subList[E]) PushAt(item E, pos int) {
+	v.check()
+	if pos < 0 || pos > v.size {
+		return
+	}
+	v.parent.PushAt(item, v.offset+pos)
+	v.changed(1)
+}
+
+func (v *subList[E]) DeleteAt(pos int) error {
+	v.check()
+	if err := v.checkPosition(pos); err != nil {
+		return err
+	}
+	if err := v.parent.DeleteAt(v.offset + pos); err != nil {
+		return err
+	}
+	v.changed(-1)
+	return nil
+}
+
+func (v *subList[E]) Delete(item E) error {
+	pos, err := v.Index(item)
+	if err != nil {
+		return err
+	}
+	return v.DeleteAt(pos)
+}
+
+func (v *subList[E]) Contains(item E) bool {
+	_, err := v.Index(item)
+	return err == nil
+}
+
+func (v *subList[E]) Index(item E) (int, error) {
+	eq := v.eq()
+	for it := v.Iterator(); it.HasNext(); {
+		i, x := it.NextWithIndex()
+		if eq(x, item) {
+			return i, nil
+		}
+	}
+	return 0, ErrItemNotFound{item}
+}
+
+// SubList returns a view of a range of the view, changes made through it
+// go through to this view and to its parent. Like slicing, if the positions
+// are not 0 <= from <= to <= size well this method panics with
+// ErrPositionNegative or ErrIndexOutOfBound error.
+func (v *subList[E]) SubList(from, to int) List[E] {
+	v.check()
+	return newSubList[E](v, from, to)
+}
+
+func (v *subList[E]) RemoveRange(from, to int) error {
+	v.check()
+	if err := checkRange(from, to, v.size); err != nil {
+		return err
+	}
+	if err := v.parent.RemoveRange(v.offset+from, v.offset+to); err != nil {
+		return err
+	}
+	v.changed(from - to)
+	return nil
+}
+
+func (v *subList[E]) InsertAll(pos int, items Iterable[E]) error {
+	v.check()
+	if err := checkInsert(pos, v.size); err != nil {
+		return err
+	}
+	// copied first, so that the view can insert itself
+	inserted := NewSlice(iterableItems(items)...)
+	if err := v.parent.InsertAll(v.offset+pos, inserted); err != nil {
+		return err
+	}
+	v.changed(inserted.Size())
+	return nil
+}
+
+func (v *subList[E]) String() string {
+	var sb strings.Builder
+	sb.WriteString("[")
+	for it := v.Iterator(); it.HasNext(); {
+		var s string
+		i, item := it.NextWithIndex()
+		if i >= v.size-1 {
+			s = fmt.Sprintf("%v", item)
+		} else {
+			s = fmt.Sprintf("%v, ", item)
+		}
+		sb.WriteString(s)
+	}
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// subListIterator is a ListIterator of the parent bounded to the range of
+// the view, the changes it makes are reported to the view.
+type subListIterator[E any] struct {
+	view   *subList[E]
+	it     ListIterator[E]
+	cursor int
+	last   int
+}
+
+func (it *subListIterator[E]) HasNext() bool {
+	return it.cursor < it.view.size
+}
+
+func (it *subListIterator[E]) Next() E {
+	_, item := it.NextWithIndex()
+	return item
+}
+
+func (it *subListIterator[E]) NextWithIndex() (int, E) {
+	it.view.check()
+	item := it.it.Next()
+	it.last = it.cursor
+	it.cursor++
+	return it.last, item
+}
+
+func (it *subListIterator[E]) HasPrevious() bool {
+	return it.cursor > 0
+}
+
+func (it *subListIterator[E]) Previous() E {
+	it.view.check()
+	if !it.HasPrevious() {
+		panic(ErrIllegalIteratorState)
+	}
+	item := it.it.Previous()
+	it.cursor--
+	it.last = it.cursor
+	return item
+}
+
+func (it *subListIterator[E]) Remove() error {
+	if it.last < 0 {
+		return ErrIllegalIteratorState
+	}
+	it.view.check()
+	if err := it.it.Remove(); err != nil {
+		return err
+	}
+	if it.last < it.cursor {
+		it.cursor--
+	}
+	it.last = -1
+	it.view.changed(-1)
+	return nil
+}
+
+func (it *subListIterator[E]) Set(item E) error {
+	if it.last < 0 {
+		return ErrIllegalIteratorState
+	}
+	it.view.check()
+	return it.it.Set(item)
+}
+
+func (it *subListIterator[E]) Add(item E) {
+	it.view.check()
+	it.it.Add(item)
+	it.cursor++
+	it.last = -1
+	it.view.changed(1)
+}
@@ -0,0 +1,267 @@
+package collection
+
+import (
+	"slices"
+	"testing"
+)
+
+// viewLists builds every list kind supporting views from items.
+var viewLists = []struct {
+	name string
+	new  func(items []int) viewParent[int]
+}{
+	{name: "slice", new: func(items []int) viewParent[int] { return NewSlice(slices.Clone(items)...) }},
+	{name: "linked list", new: func(items []int) viewParent[int] { return NewLinkedList(items...) }},
+}
+
+func listContent(l List[int]) []int {
+	items := []int{}
+	for it := l.Iterator(); it.HasNext(); {
+		items = append(items, it.Next())
+	}
+	return items
+}
+
+func TestSubList(t *testing.T) {
+	useCases := []struct {
+		description string
+		from, to    int
+		want        []int
+		err         error
+	}{
+		{description: "whole list", from: 0, to: 5, want: []int{1, 2, 3, 4, 5}},
+		{description: "middle range", from: 1, to: 4, want: []int{2, 3, 4}},
+		{description: "empty range", from: 2, to: 2, want: []int{}},
+		{description: "negative from", from: -1, to: 2, err: ErrPositionNegative},
+		{description: "to out of bound", from: 0, to: 6, err: ErrIndexOutOfBound{6, 5}},
+		{description: "from after to", from: 3, to: 2, err: ErrIndexOutOfBound{3, 2}},
+	}
+
+	for _, f := range viewLists {
+		for _, tt := range useCases {
+			var view List[int]
+			err := rangePanic(func() {
+				view = f.new([]int{1, 2, 3, 4, 5}).SubList(tt.from, tt.to)
+			})
+			if err != tt.err {
+				t.Errorf("test: %s %s want %v got %v", f.name, tt.description, tt.err, err)
+				continue
+			}
+			if err == nil {
+				if result := listContent(view); !slices.Equal(result, tt.want) || view.Size() != len(tt.want) {
+					t.Errorf("test: %s %s want %v got %v", f.name, tt.description, tt.want, result)
+				}
+			}
+		}
+	}
+}
+
+// rangePanic returns the error fn panics with, or nil when it does not panic.
+func rangePanic(fn func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = r.(error)
+		}
+	}()
+	fn()
+	return nil
+}
+
+func TestSubList_WriteThrough(t *testing.T) {
+	useCases := []struct {
+		description string
+		change      func(view List[int])
+		view        []int
+		parent      []int
+	}{
+		{description: "set", change: func(view List[int]) { view.Set(9, 0) }, view: []int{9, 3, 4}, parent: []int{1, 9, 3, 4, 5}},
+		{description: "push back", change: func(view List[int]) { view.PushBack(9) }, view: []int{2, 3, 4, 9}, parent: []int{1, 2, 3, 4, 9, 5}},
+		{description: "push front", change: func(view List[int]) { view.PushFront(9) }, view: []int{9, 2, 3, 4}, parent: []int{1, 9, 2, 3, 4, 5}},
+		{description: "push out of range", change: func(view List[int]) { view.PushAt(9, 4) }, view: []int{2, 3, 4}, parent: []int{1, 2, 3, 4, 5}},
+		{description: "delete at", change: func(view List[int]) { view.DeleteAt(1) }, view: []int{2, 4}, parent: []int{1, 2, 4, 5}},
+		{description: "delete item", change: func(view List[int]) { view.Delete(4) }, view: []int{2, 3}, parent: []int{1, 2, 3, 5}},
+		{description: "delete item out of the view", change: func(view List[int]) { view.Delete(5) }, view: []int{2, 3, 4}, parent: []int{1, 2, 3, 4, 5}},
+		{description: "remove range", change: func(view List[int]) { view.(viewParent[int]).RemoveRange(0, 2) }, view: []int{4}, parent: []int{1, 4, 5}},
+		{description: "insert all", change: func(view List[int]) { view.(viewParent[int]).InsertAll(1, NewSlice(7, 8)) }, view: []int{2, 7, 8, 3, 4}, parent: []int{1, 2, 7, 8, 3, 4, 5}},
+		{description: "insert itself", change: func(view List[int]) { view.(viewParent[int]).InsertAll(3, view) }, view: []int{2, 3, 4, 2, 3, 4}, parent: []int{1, 2, 3, 4, 2, 3, 4, 5}},
+		{description: "nested view", change: func(view List[int]) {
+			inner := view.(viewParent[int]).SubList(1, 3)
+			inner.DeleteAt(0)
+			inner.PushBack(9)
+		}, view: []int{2, 4, 9}, parent: []int{1, 2, 4, 9, 5}},
+		{description: "clear through the view", change: func(view List[int]) {
+			for it := view.Iterator().(MutableIterator[int]); it.HasNext(); {
+				it.Next()
+				it.Remove()
+			}
+		}, view: []int{}, parent: []int{1, 5}},
+	}
+
+	for _, f := range viewLists {
+		for _, tt := range useCases {
+			parent := f.new([]int{1, 2, 3, 4, 5})
+			view := parent.SubList(1, 4)
+			tt.change(view)
+			if result := listContent(view); !slices.Equal(result, tt.view) || view.Size() != len(tt.view) {
+				t.Errorf("test: %s %s view want %v got %v", f.name, tt.description, tt.view, result)
+			}
+			if result := listContent(parent); !slices.Equal(result, tt.parent) {
+				t.Errorf("test: %s %s parent want %v got %v", f.name, tt.description, tt.parent, result)
+			}
+		}
+	}
+}
+
+func TestSubList_Errors(t *testing.T) {
+	for _, f := range viewLists {
+		view := f.new([]int{1, 2, 3, 4, 5}).SubList(1, 3)
+		useCases := []struct {
+			description string
+			err         error
+			want        error
+		}{
+			{description: "get out of bound", err: second(view.GetAt(2)), want: ErrIndexOutOfBound{2, 2}},
+			{description: "get negative", err: second(view.GetAt(-1)), want: ErrPositionNegative},
+			{description: "set out of bound", err: view.Set(0, 2), want: ErrIndexOutOfBound{2, 2}},
+			{description: "delete out of bound", err: view.DeleteAt(2), want: ErrIndexOutOfBound{2, 2}},
+			{description: "delete missing item", err: view.Delete(5), want: ErrItemNotFound{5}},
+			{description: "iterator out of bound", err: second(view.ListIterator(3)), want: ErrIndexOutOfBound{3, 2}},
+		}
+		for _, tt := range useCases {
+			if tt.err != tt.want {
+				t.Errorf("test: %s %s want %v got %v", f.name, tt.description, tt.want, tt.err)
+			}
+		}
+
+		empty := f.new([]int{1}).SubList(1, 1)
+		if _, err := empty.Front(); err != ErrEmptyCollection {
+			t.Errorf("test: %s front of empty view want %v got %v", f.name, ErrEmptyCollection, err)
+		}
+	}
+}
+
+func second[T any](_ T, err error) error {
+	return err
+}
+
+func TestSubList_ParentChanged(t *testing.T) {
+	useCases := []struct {
+		description string
+		change      func(parent viewParent[int])
+		invalid     bool
+	}{
+		{description: "set in the parent", change: func(parent viewParent[int]) { parent.Set(9, 2) }},
+		{description: "push to the parent", change: func(parent viewParent[int]) { parent.PushBack(9) }, invalid: true},
+		{description: "delete from the parent", change: func(parent viewParent[int]) { parent.DeleteAt(0) }, invalid: true},
+		{description: "remove range from the parent", change: func(parent viewParent[int]) { parent.RemoveRange(0, 1) }, invalid: true},
+		{description: "remove empty range from the parent", change: func(parent viewParent[int]) { parent.RemoveRange(2, 2) }},
+		{description: "insert nothing into the parent", change: func(parent viewParent[int]) { parent.InsertAll(0, NewSlice[int]()) }},
+		{description: "sibling view removing an empty range", change: func(parent viewParent[int]) {
+			parent.SubList(0, 1).(viewParent[int]).RemoveRange(0, 0)
+		}},
+		{description: "sibling view", change: func(parent viewParent[int]) {
+			sibling := parent.SubList(0, 1)
+			sibling.PushBack(9)
+		}, invalid: true},
+	}
+
+	for _, f := range viewLists {
+		for _, tt := range useCases {
+			parent := f.new([]int{1, 2, 3, 4, 5})
+			view := parent.SubList(1, 4)
+			tt.change(parent)
+			err := modificationPanic(func() {
+				view.Size()
+			})
+			if (err != nil) != tt.invalid {
+				t.Errorf("test: %s %s want invalid %v got %v", f.name, tt.description, tt.invalid, err)
+			}
+		}
+	}
+}
+
+func TestSubList_ListIterator(t *testing.T) {
+	for _, f := range viewLists {
+		parent := f.new([]int{1, 2, 3, 4, 5})
+		view := parent.SubList(1, 4)
+		it, _ := view.ListIterator(3)
+		var backward []int
+		for it.HasPrevious() {
+			item := it.Previous()
+			backward = append(backward, item)
+			if item == 3 {
+				it.Set(30)
+				it.Add(25)
+				it.Previous()
+			}
+		}
+		if !slices.Equal(backward, []int{4, 3, 2}) {
+			t.Errorf("test: %s backward want %v got %v", f.name, []int{4, 3, 2}, backward)
+		}
+		want := []int{1, 2, 25, 30, 4, 5}
+		if result := listContent(parent); !slices.Equal(result, want) || view.Size() != 4 {
+			t.Errorf("test: %s parent want %v got %v", f.name, want, result)
+		}
+		if s := view.(*subList[int]).String(); s != "[2, 25, 30, 4]" {
+			t.Errorf("test: %s String want %s got %s", f.name, "[2, 25, 30, 4]", s)
+		}
+	}
+}
+
+func TestRemoveRange(t *testing.T) {
+	useCases := []struct {
+		description string
+		from, to    int
+		want        []int
+		err         error
+	}{
+		{description: "remove everything", from: 0, to: 4, want: []int{}},
+		{description: "remove middle", from: 1, to: 3, want: []int{1, 4}},
+		{description: "remove nothing", from: 2, to: 2, want: []int{1, 2, 3, 4}},
+		{description: "negative from", from: -1, to: 2, want: []int{1, 2, 3, 4}, err: ErrPositionNegative},
+		{description: "to out of bound", from: 1, to: 5, want: []int{1, 2, 3, 4}, err: ErrIndexOutOfBound{5, 4}},
+	}
+
+	for _, f := range viewLists {
+		for _, tt := range useCases {
+			l := f.new([]int{1, 2, 3, 4})
+			err := l.RemoveRange(tt.from, tt.to)
+			if result := listContent(l); !slices.Equal(result, tt.want) || err != tt.err || l.Size() != len(tt.want) {
+				t.Errorf("test: %s %s want (%v, %v) got (%v, %v)", f.name, tt.description, tt.want, tt.err, result, err)
+			}
+		}
+	}
+}
+
+func TestInsertAll(t *testing.T) {
+	useCases := []struct {
+		description string
+		pos         int
+		items       Iterable[int]
+		want        []int
+		err         error
+	}{
+		{description: "insert at front", pos: 0, items: NewSlice(7, 8), want: []int{7, 8, 1, 2, 3}},
+		{description: "insert in the middle", pos: 1, items: NewLinkedList(7, 8), want: []int{1, 7, 8, 2, 3}},
+		{description: "insert at back", pos: 3, items: NewSlice(7), want: []int{1, 2, 3, 7}},
+		{description: "insert nothing", pos: 1, items: NewSlice[int](), want: []int{1, 2, 3}},
+		{description: "negative position", pos: -1, items: NewSlice(7), want: []int{1, 2, 3}, err: ErrPositionNegative},
+		{description: "position out of bound", pos: 4, items: NewSlice(7), want: []int{1, 2, 3}, err: ErrIndexOutOfBound{4, 3}},
+	}
+
+	for _, f := range viewLists {
+		for _, tt := range useCases {
+			l := f.new([]int{1, 2, 3})
+			err := l.InsertAll(tt.pos, tt.items)
+			if result := listContent(l); !slices.Equal(result, tt.want) || err != tt.err || l.Size() != len(tt.want) {
+				t.Errorf("test: %s %s want (%v, %v) got (%v, %v)", f.name, tt.description, tt.want, tt.err, result, err)
+			}
+		}
+
+		l := f.new([]int{1, 2})
+		l.InsertAll(1, l)
+		if result := listContent(l); !slices.Equal(result, []int{1, 1, 2, 2}) {
+			t.Errorf("test: %s insert itself want %v got %v", f.name, []int{1, 1, 2, 2}, result)
+		}
+	}
+}
@@ -0,0 +1,406 @@
+package collection
+
+import (
+	"sync"
+	"time"
+)
+
+// synchronizedCollection guards every call to the wrapped collection with a
+// read-write mutex, readers run in parallel while writers are exclusive.
+type synchronizedCollection[E any] struct {
+	mu *sync.RWMutex
+	c  Collection[E]
+}
+
+// Iterator returns an iterator over a snapshot of the items taken under the
+// read lock, so it never observes nor conflicts with later writes. Its
+// Remove returns ErrUnsupportedOperation.
+func (s *synchronizedCollection[E]) Iterator() Iterator[E] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	items := make([]E, 0, s.c.Size())
+	for it := s.c.Iterator(); it.HasNext(); {
+		items = append(items, it.Next())
+	}
+	return newSnapshotIterator(items)
+}
+
+func (s *synchronizedCollection[E]) Empty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.c.Empty()
+}
+
+func (s *synchronizedCollection[E]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.c.Size()
+}
+
+func (s *synchronizedCollection[E]) Push(item E) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.c.Push(item)
+}
+
+func (s *synchronizedCollection[E]) Contains(item E) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.c.Contains(item)
+}
+
+func (s *synchronizedCollection[E]) Delete(item E) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.Delete(item)
+}
+
+type synchronizedList[E any] struct {
+	synchronizedCollection[E]
+	l List[E]
+}
+
+// SynchronizedList returns a List safe for concurrent use backed by l, l must
+// not be used directly afterwards. Iterator works on a snapshot while each
+// call to the ListIterator is guarded on its own, so a ListIterator still
+// panics with ErrConcurrentModification when another goroutine changes the
+// list during the walk.
+func SynchronizedList[E any](l List[E]) List[E] {
+	return &synchronizedList[E]{synchronizedCollection: synchronizedCollection[E]{mu: &sync.RWMutex{}, c: l}, l: l}
+}
+
+func (s *synchronizedList[E]) Back() (E, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.l.Back()
+}
+
+func (s *synchronizedList[E]) Front() (E, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.l.Front()
+}
+
+func (s *synchronizedList[E]) PushBack(item E) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.l.PushBack(item)
+}
+
+func (s *synchronizedList[E]) PushFront(item E) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.l.PushFront(item)
+}
+
+func (s *synchronizedList[E]) Set(item E, pos int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.l.Set(item, pos)
+}
+
+func (s *synchronizedList[E]) Index(item E) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.l.Index(item)
+}
+
+func (s *synchronizedList[E]) GetAt(pos int) (E, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.l.GetAt(pos)
+}
+
+func (s *synchronizedList[E]) PushAt(item E, pos int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.l.PushAt(item, pos)
+}
+
+func (s *synchronizedList[E]) DeleteAt(pos int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.l.DeleteAt(pos)
+}
+
+func (s *synchronizedList[E]) ListIterator(startPos int) (ListIterator[E], error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	it, err := s.l.ListIterator(startPos)
+	if err != nil {
+		return nil, err
+	}
+	return &synchronizedListIterator[E]{mu: s.mu, it: it}, nil
+}
+
+// synchronizedListIterator guards every call to the wrapped iterator with the mutex of its list.
+type synchronizedListIterator[E any] struct {
+	mu *sync.RWMutex
+	it ListIterator[E]
+}
+
+func (s *synchronizedListIterator[E]) HasNext() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.it.HasNext()
+}
+
+func (s *synchronizedListIterator[E]) Next() E {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.it.Next()
+}
+
+func (s *synchronizedListIterator[E]) NextWithIndex() (int, E) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.it.NextWithIndex()
+}
+
+func (s *synchronizedListIterator[E]) HasPrevious() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.it.HasPrevious()
+}
+
+func (s *synchronizedListIterator[E]) Previous() E {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.it.Previous()
+}
+
+func (s *synchronizedListIterator[E]) Remove() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.it.Remove()
+}
+
+func (s *synchronizedListIterator[E]) Set(item E) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.it.Set(item)
+}
+
+func (s *synchronizedListIterator[E]) Add(item E) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.it.Add(item)
+}
+
+// SynchronizedSet returns a Set safe for concurrent use backed by set, set
+// must not be used directly afterwards. Iterator works on a snapshot.
+func SynchronizedSet[E comparable](set Set[E]) Set[E] {
+	return &synchronizedCollection[E]{mu: &sync.RWMutex{}, c: set}
+}
+
+type synchronizedMap[K comparable, V any] struct {
+	mu sync.RWMutex
+	m  Map[K, V]
+}
+
+// SynchronizedMap returns a Map safe for concurrent use backed by m, m must
+// not be used directly afterwards. Keys, Values and EntryList return the
+// copies built by m under the read lock. The functions given to Compute,
+// Merge, ForEach and the like run under the lock so they must not use the
+// map.
+func SynchronizedMap[K comparable, V any](m Map[K, V]) Map[K, V] {
+	return &synchronizedMap[K, V]{m: m}
+}
+
+func (s *synchronizedMap[K, V]) Empty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Empty()
+}
+
+func (s *synchronizedMap[K, V]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Size()
+}
+
+func (s *synchronizedMap[K, V]) Get(key K) (V, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Get(key)
+}
+
+func (s *synchronizedMap[K, V]) Put(key K, value V) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m.Put(key, value)
+}
+
+func (s *synchronizedMap[K, V]) ContainsKey(key K) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.ContainsKey(key)
+}
+
+func (s *synchronizedMap[K, V]) ContainsValue(value V) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.ContainsValue(value)
+}
+
+func (s *synchronizedMap[K, V]) Delete(key K) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.Delete(key)
+}
+
+func (s *synchronizedMap[K, V]) Keys() Set[K] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Keys()
+}
+
+func (s *synchronizedMap[K, V]) Values() Collection[V] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Values()
+}
+
+func (s *synchronizedMap[K, V]) EntryList() Collection[*Entry[K, V]] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.EntryList()
+}
+
+func (s *synchronizedMap[K, V]) GetOrDefault(key K, def V) V {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.GetOrDefault(key, def)
+}
+
+func (s *synchronizedMap[K, V]) PutIfAbsent(key K, value V) (actual V, loaded bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.PutIfAbsent(key, value)
+}
+
+func (s *synchronizedMap[K, V]) ComputeIfAbsent(key K, fn func(key K) V) V {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.ComputeIfAbsent(key, fn)
+}
+
+func (s *synchronizedMap[K, V]) ComputeIfPresent(key K, fn func(key K, old V) (V, bool)) (V, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.ComputeIfPresent(key, fn)
+}
+
+func (s *synchronizedMap[K, V]) Compute(key K, fn func(key K, old V, found bool) (V, bool)) (V, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.Compute(key, fn)
+}
+
+func (s *synchronizedMap[K, V]) Merge(key K, value V, fn func(old V, value V) (V, bool)) (V, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.Merge(key, value, fn)
+}
+
+func (s *synchronizedMap[K, V]) Replace(key K, value V) (old V, replaced bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.Replace(key, value)
+}
+
+func (s *synchronizedMap[K, V]) ReplaceAll(fn func(key K, value V) V) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m.ReplaceAll(fn)
+}
+
+func (s *synchronizedMap[K, V]) ForEach(fn func(key K, value V)) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s.m.ForEach(fn)
+}
+
+func (s *synchronizedMap[K, V]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m.Clear()
+}
+
+// PutAll copies the pairs of other before taking the lock, so that the map
+// can be given itself.
+func (s *synchronizedMap[K, V]) PutAll(other Map[K, V]) {
+	entries := other.EntryList()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for it := entries.Iterator(); it.HasNext(); {
+		e := it.Next()
+		s.m.Put(e.key, e.value)
+	}
+}
+
+type synchronizedCache[K comparable, V any] struct {
+	mu sync.RWMutex
+	c  Cache[K, V]
+}
+
+// SynchronizedCache returns a Cache safe for concurrent use backed by c, c
+// must not be used directly afterwards. Get records the access so it takes
+// the write lock, only Peek, Len, Cost and Stats run in parallel.
+func SynchronizedCache[K comparable, V any](c Cache[K, V]) Cache[K, V] {
+	return &synchronizedCache[K, V]{c: c}
+}
+
+func (s *synchronizedCache[K, V]) Get(key K) (V, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.Get(key)
+}
+
+func (s *synchronizedCache[K, V]) Peek(key K) (V, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.c.Peek(key)
+}
+
+func (s *synchronizedCache[K, V]) Put(key K, value V) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.c.Put(key, value)
+}
+
+func (s *synchronizedCache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.c.PutWithTTL(key, value, ttl)
+}
+
+func (s *synchronizedCache[K, V]) Remove(key K) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.Remove(key)
+}
+
+func (s *synchronizedCache[K, V]) RemoveExpired() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.RemoveExpired()
+}
+
+func (s *synchronizedCache[K, V]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.c.Len()
+}
+
+func (s *synchronizedCache[K, V]) Cost() int64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.c.Cost()
+}
+
+func (s *synchronizedCache[K, V]) Stats() CacheStats {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.c.Stats()
+}
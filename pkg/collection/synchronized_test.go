@@ -0,0 +1,195 @@
+package collection
+
+import (
+	"sync"
+	"testing"
+)
+
+const stressGoroutines = 8
+const stressOperations = 1000
+
+func TestSynchronizedList(t *testing.T) {
+	useCases := []struct {
+		description string
+		list        List[int]
+	}{
+		{description: "synchronized slice", list: SynchronizedList[int](NewSlice[int]())},
+		{description: "synchronized linked list", list: SynchronizedList[int](NewLinkedList[int]())},
+	}
+
+	for _, tt := range useCases {
+		var wg sync.WaitGroup
+		for g := 0; g < stressGoroutines; g++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < stressOperations; i++ {
+					tt.list.PushBack(i)
+					tt.list.Front()
+					tt.list.GetAt(i / 2)
+					if i%100 == 0 {
+						tt.list.Contains(-1)
+						tt.list.Iterator()
+					}
+				}
+			}()
+		}
+		wg.Wait()
+
+		want := stressGoroutines * stressOperations
+		if tt.list.Size() != want {
+			t.Errorf("test: %s size want %v got %v", tt.description, want, tt.list.Size())
+		}
+
+		for g := 0; g < stressGoroutines; g++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < stressOperations; i++ {
+					tt.list.DeleteAt(0)
+				}
+			}()
+		}
+		wg.Wait()
+
+		if !tt.list.Empty() {
+			t.Errorf("test: %s empty want %v got %v", tt.description, true, tt.list.Empty())
+		}
+	}
+}
+
+func TestSynchronizedList_ListIterator(t *testing.T) {
+	list := SynchronizedList[int](NewLinkedList(1, 2, 3))
+	it, err := list.ListIterator(0)
+	if err != nil {
+		t.Fatalf("test: list iterator want %v got %v", nil, err)
+	}
+	for it.HasNext() {
+		if item := it.Next(); item == 2 {
+			it.Remove()
+		} else {
+			it.Set(item * 10)
+		}
+	}
+	it.Add(40)
+
+	want := []int{10, 30, 40}
+	for i, item := range want {
+		got, _ := list.GetAt(i)
+		if got != item {
+			t.Errorf("test: item at %d want %v got %v", i, item, got)
+		}
+	}
+	if _, err := list.ListIterator(5); err != (ErrIndexOutOfBound{5, 3}) {
+		t.Errorf("test: list iterator out of bound want %v got %v", ErrIndexOutOfBound{5, 3}, err)
+	}
+}
+
+func TestSynchronizedSet(t *testing.T) {
+	set := SynchronizedSet[int](NewHashSet[int]())
+
+	var wg sync.WaitGroup
+	for g := 0; g < stressGoroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < stressOperations; i++ {
+				set.Push(i)
+				set.Contains(i)
+				if i%2 == 1 {
+					set.Delete(i)
+				}
+				for it := set.Iterator(); it.HasNext(); {
+					it.Next()
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i := 0; i < stressOperations; i += 2 {
+		if !set.Contains(i) {
+			t.Errorf("test: contains %d want %v got %v", i, true, false)
+		}
+	}
+}
+
+func TestSynchronizedMap(t *testing.T) {
+	useCases := []struct {
+		description string
+		table       Map[int, int]
+	}{
+		{description: "synchronized hash map", table: SynchronizedMap[int, int](NewHashMap[int, int]())},
+		{description: "synchronized tree map", table: SynchronizedMap[int, int](NewTreeMap[int, int]())},
+	}
+
+	for _, tt := range useCases {
+		var wg sync.WaitGroup
+		for g := 0; g < stressGoroutines; g++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < stressOperations; i++ {
+					tt.table.Put(g*stressOperations+i, i)
+					tt.table.Get(i)
+					if i%100 == 0 {
+						tt.table.ContainsValue(-1)
+					}
+					if i%2 == 1 {
+						tt.table.Delete(g*stressOperations + i)
+					}
+				}
+				tt.table.Keys()
+			}()
+		}
+		wg.Wait()
+
+		want := stressGoroutines * stressOperations / 2
+		if tt.table.Size() != want {
+			t.Errorf("test: %s size want %v got %v", tt.description, want, tt.table.Size())
+		}
+		if tt.table.Keys().Size() != want || tt.table.Values().Size() != want || tt.table.EntryList().Size() != want {
+			t.Errorf("test: %s views size want %v", tt.description, want)
+		}
+	}
+}
+
+func TestSynchronizedCache(t *testing.T) {
+	useCases := []struct {
+		description string
+		cache       Cache[int, int]
+	}{
+		{description: "lru cache", cache: SynchronizedCache[int, int](NewLRUCache[int, int](100))},
+		{description: "lfu cache", cache: SynchronizedCache[int, int](NewLFUCache[int, int](100))},
+	}
+
+	for _, tt := range useCases {
+		var wg sync.WaitGroup
+		for g := 0; g < stressGoroutines; g++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < stressOperations; i++ {
+					tt.cache.Put(g*stressOperations+i, i)
+					tt.cache.Get(i)
+					tt.cache.Peek(i)
+					if i%2 == 1 {
+						tt.cache.Remove(g*stressOperations + i)
+					}
+					tt.cache.Len()
+					tt.cache.Cost()
+					if i%100 == 0 {
+						tt.cache.RemoveExpired()
+					}
+				}
+			}()
+		}
+		wg.Wait()
+
+		stats := tt.cache.Stats()
+		if tt.cache.Len() > 100 || tt.cache.Cost() != int64(tt.cache.Len()) || stats.Hits+stats.Misses != stressGoroutines*stressOperations {
+			t.Errorf("test: %s want len at most 100 and %d gets got %d and %+v", tt.description, stressGoroutines*stressOperations, tt.cache.Len(), stats)
+		}
+	}
+}
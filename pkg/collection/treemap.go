@@ -0,0 +1,289 @@
+package collection
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// TreeMap is a Map backed by a red-black tree, keys are kept sorted by the
+// comparator given at construction time.
+// A TreeMap returned by SubMap, HeadMap or TailMap is a view that shares the
+// tree with the map it comes from, so changes made through either are visible
+// in both.
+type TreeMap[K comparable, V any] struct {
+	tree  *rbTree[K, V]
+	equal EqualFunc[V]
+	lo    *bound[K]
+	hi    *bound[K]
+}
+
+// NewTreeMap creates a TreeMap ordered by the natural order of the keys.
+func NewTreeMap[K cmp.Ordered, V any](entries ...*Entry[K, V]) *TreeMap[K, V] {
+	return NewTreeMapWithComparator(NaturalOrder[K](), entries...)
+}
+
+// NewTreeMapWithComparator creates a TreeMap ordered by compare.
+func NewTreeMapWithComparator[K comparable, V any](compare Comparator[K], entries ...*Entry[K, V]) *TreeMap[K, V] {
+	m := &TreeMap[K, V]{tree: newRBTree[K, V](compare)}
+	for _, e := range entries {
+		m.Put(e.key, e.value)
+	}
+	return m
+}
+
+// NewTreeMapWith creates an empty TreeMap ordered by compare and configured
+// with the given value options.
+func NewTreeMapWith[K comparable, V any](compare Comparator[K], opts ...Option[V]) *TreeMap[K, V] {
+	return &TreeMap[K, V]{tree: newRBTree[K, V](compare), equal: newOptions(opts...).equal}
+}
+
+func (t *TreeMap[K, V]) eq() EqualFunc[V] {
+	if t.equal == nil {
+		return DefaultEqual[V]()
+	}
+	return t.equal
+}
+
+func (t *TreeMap[K, V]) tooLow(key K) bool {
+	return t.tree.below(key, t.lo)
+}
+
+func (t *TreeMap[K, V]) tooHigh(key K) bool {
+	return t.tree.above(key, t.hi)
+}
+
+func (t *TreeMap[K, V]) inRange(key K) bool {
+	return !t.tooLow(key) && !t.tooHigh(key)
+}
+
+// lowest returns the first node inside the range of the map.
+func (t *TreeMap[K, V]) lowest() *rbNode[K, V] {
+	var n *rbNode[K, V]
+	if t.lo == nil {
+		n = t.tree.first()
+	} else {
+		n = t.tree.ceiling(t.lo.value, t.lo.inclusive)
+	}
+	if n == nil || t.tooHigh(n.key) {
+		return nil
+	}
+	return n
+}
+
+// highest returns the last node inside the range of the map.
+func (t *TreeMap[K, V]) highest() *rbNode[K, V] {
+	var n *rbNode[K, V]
+	if t.hi == nil {
+		n = t.tree.last()
+	} else {
+		n = t.tree.floor(t.hi.value, t.hi.inclusive)
+	}
+	if n == nil || t.tooLow(n.key) {
+		return nil
+	}
+	return n
+}
+
+// nodes returns a sequence over the nodes inside the range of the map in ascending order.
+func (t *TreeMap[K, V]) nodes() iter.Seq[*rbNode[K, V]] {
+	return func(yield func(*rbNode[K, V]) bool) {
+		for n := t.lowest(); n != nil && !t.tooHigh(n.key); n = successor(n) {
+			if !yield(n) {
+				return
+			}
+		}
+	}
+}
+
+// All returns a sequence over the pairs of the map in ascending order of their keys.
+func (t *TreeMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := range t.nodes() {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+func (t *TreeMap[K, V]) Empty() bool {
+	return t.lowest() == nil
+}
+
+// Size returns the number of entries in the map, on views it takes linear time.
+func (t *TreeMap[K, V]) Size() int {
+	if t.lo == nil && t.hi == nil {
+		return t.tree.size
+	}
+	size := 0
+	for range t.nodes() {
+		size++
+	}
+	return size
+}
+
+func (t *TreeMap[K, V]) Get(key K) (V, bool) {
+	if !t.inRange(key) {
+		return *new(V), false
+	}
+	n := t.tree.find(key)
+	if n == nil {
+		return *new(V), false
+	}
+	return n.value, true
+}
+
+// Put sets the value of key, on views keys out of range are ignored.
+func (t *TreeMap[K, V]) Put(key K, value V) {
+	if t.inRange(key) {
+		t.tree.put(key, value)
+	}
+}
+
+func (t *TreeMap[K, V]) ContainsKey(key K) bool {
+	return t.inRange(key) && t.tree.find(key) != nil
+}
+
+func (t *TreeMap[K, V]) ContainsValue(value V) bool {
+	eq := t.eq()
+	for n := range t.nodes() {
+		if eq(n.value, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *TreeMap[K, V]) Delete(key K) bool {
+	if !t.inRange(key) {
+		return false
+	}
+	_, ok := t.tree.remove(key)
+	return ok
+}
+
+// Keys returns the keys of the map in ascending order.
+func (t *TreeMap[K, V]) Keys() Set[K] {
+	set := NewTreeSetWithComparator[K](t.tree.compare)
+	for n := range t.nodes() {
+		set.Push(n.key)
+	}
+	return set
+}
+
+// Values returns the values of the map in ascending order of their keys.
+func (t *TreeMap[K, V]) Values() Collection[V] {
+	lst := NewSlice[V]()
+	for n := range t.nodes() {
+		lst.PushBack(n.value)
+	}
+	return lst
+}
+
+// EntryList returns the entries of the map in ascending order of their keys.
+func (t *TreeMap[K, V]) EntryList() Collection[*Entry[K, V]] {
+	lst := NewSlice[*Entry[K, V]]()
+	for n := range t.nodes() {
+		lst.PushBack(NewEntry(n.key, n.value))
+	}
+	return lst
+}
+
+// FirstKey returns the lowest key of the map, but
+// if map is empty well this method return ErrEmptyCollection error.
+func (t *TreeMap[K, V]) FirstKey() (K, error) {
+	n := t.lowest()
+	if n == nil {
+		return *new(K), ErrEmptyCollection
+	}
+	return n.key, nil
+}
+
+// LastKey returns the highest key of the map, but
+// if map is empty well this method return ErrEmptyCollection error.
+func (t *TreeMap[K, V]) LastKey() (K, error) {
+	n := t.highest()
+	if n == nil {
+		return *new(K), ErrEmptyCollection
+	}
+	return n.key, nil
+}
+
+// Floor returns the entry with the greatest key less than or equal to key.
+func (t *TreeMap[K, V]) Floor(key K) (*Entry[K, V], bool) {
+	if t.tooHigh(key) {
+		return t.nodeEntry(t.highest())
+	}
+	return t.nodeEntry(t.tree.floor(key, true))
+}
+
+// Ceiling returns the entry with the least key greater than or equal to key.
+func (t *TreeMap[K, V]) Ceiling(key K) (*Entry[K, V], bool) {
+	if t.tooLow(key) {
+		return t.nodeEntry(t.lowest())
+	}
+	return t.nodeEntry(t.tree.ceiling(key, true))
+}
+
+// Lower returns the entry with the greatest key strictly less than key.
+func (t *TreeMap[K, V]) Lower(key K) (*Entry[K, V], bool) {
+	if t.tooHigh(key) {
+		return t.nodeEntry(t.highest())
+	}
+	return t.nodeEntry(t.tree.floor(key, false))
+}
+
+// Higher returns the entry with the least key strictly greater than key.
+func (t *TreeMap[K, V]) Higher(key K) (*Entry[K, V], bool) {
+	if t.tooLow(key) {
+		return t.nodeEntry(t.lowest())
+	}
+	return t.nodeEntry(t.tree.ceiling(key, false))
+}
+
+func (t *TreeMap[K, V]) nodeEntry(n *rbNode[K, V]) (*Entry[K, V], bool) {
+	if n == nil || !t.inRange(n.key) {
+		return nil, false
+	}
+	return NewEntry(n.key, n.value), true
+}
+
+// HeadMap returns a view of the entries whose keys are strictly less than to.
+func (t *TreeMap[K, V]) HeadMap(to K) *TreeMap[K, V] {
+	return t.view(nil, &bound[K]{to, false})
+}
+
+// TailMap returns a view of the entries whose keys are greater than or equal to from.
+func (t *TreeMap[K, V]) TailMap(from K) *TreeMap[K, V] {
+	return t.view(&bound[K]{from, true}, nil)
+}
+
+// SubMap returns a view of the entries whose keys range from from, inclusive, to to, exclusive.
+func (t *TreeMap[K, V]) SubMap(from, to K) *TreeMap[K, V] {
+	return t.view(&bound[K]{from, true}, &bound[K]{to, false})
+}
+
+// view creates a view on the same tree whose range is the intersection of
+// the range of t and the given bounds.
+func (t *TreeMap[K, V]) view(lo, hi *bound[K]) *TreeMap[K, V] {
+	v := &TreeMap[K, V]{tree: t.tree, equal: t.equal}
+	v.lo, v.hi = t.tree.narrow(t.lo, t.hi, lo, hi)
+	return v
+}
+
+func (t *TreeMap[K, V]) String() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	first := true
+	for n := range t.nodes() {
+		if !first {
+			sb.WriteString(", ")
+		}
+		first = false
+		sb.WriteString(fmt.Sprintf("%v", NewEntry(n.key, n.value)))
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
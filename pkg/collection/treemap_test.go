@@ -0,0 +1,254 @@
+package collection
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func newTestTreeMap() *TreeMap[int, string] {
+	return NewTreeMap(
+		NewEntry(30, "thirty"),
+		NewEntry(10, "ten"),
+		NewEntry(50, "fifty"),
+		NewEntry(20, "twenty"),
+		NewEntry(40, "forty"),
+	)
+}
+
+// extremeOrder is the natural order of ints returning math.MinInt for less,
+// a valid comparator whose result can not be negated.
+func extremeOrder(a, b int) int {
+	switch {
+	case a < b:
+		return math.MinInt
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func keysOf[K comparable](set Set[K]) []K {
+	var keys []K
+	for it := set.Iterator(); it.HasNext(); {
+		keys = append(keys, it.Next())
+	}
+	return keys
+}
+
+func TestTreeMap_Size(t *testing.T) {
+	useCases := []struct {
+		description string
+		table       *TreeMap[int, string]
+		want        int
+	}{
+		{description: "empty tree map", table: NewTreeMap[int, string](), want: 0},
+		{description: "tree map with entries", table: newTestTreeMap(), want: 5},
+		{description: "tree map with duplicated keys", table: NewTreeMap(NewEntry(1, "a"), NewEntry(1, "b")), want: 1},
+	}
+
+	for _, tt := range useCases {
+		result := tt.table.Size()
+		if result != tt.want || tt.table.Empty() != (tt.want == 0) {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.want, result)
+		}
+	}
+}
+
+func TestTreeMap_Get(t *testing.T) {
+	useCases := []struct {
+		description string
+		key         int
+		want        string
+		found       bool
+	}{
+		{description: "ask value by found key", key: 20, want: "twenty", found: true},
+		{description: "ask value by not found key", key: 25, want: "", found: false},
+	}
+
+	table := newTestTreeMap()
+	for _, tt := range useCases {
+		result, ok := table.Get(tt.key)
+		if result != tt.want || ok != tt.found || table.ContainsKey(tt.key) != tt.found {
+			t.Errorf("test: %s want {%v, %v} got {%v, %v}", tt.description, tt.want, tt.found, result, ok)
+		}
+	}
+}
+
+func TestTreeMap_Delete(t *testing.T) {
+	useCases := []struct {
+		description string
+		key         int
+		result      bool
+		want        []int
+	}{
+		{description: "delete missing key", key: 25, result: false, want: []int{10, 20, 30, 40, 50}},
+		{description: "delete root key", key: 30, result: true, want: []int{10, 20, 40, 50}},
+		{description: "delete first key", key: 10, result: true, want: []int{20, 30, 40, 50}},
+	}
+
+	for _, tt := range useCases {
+		table := newTestTreeMap()
+		ok := table.Delete(tt.key)
+		if ok != tt.result || !reflect.DeepEqual(keysOf(table.Keys()), tt.want) {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.want, table)
+		}
+	}
+}
+
+func TestTreeMap_Ordered(t *testing.T) {
+	table := newTestTreeMap()
+	if keys := keysOf(table.Keys()); !reflect.DeepEqual(keys, []int{10, 20, 30, 40, 50}) {
+		t.Errorf("test: keys want %v got %v", []int{10, 20, 30, 40, 50}, keys)
+	}
+	if values := table.Values(); values.(*Slice[string]).String() != "[ten, twenty, thirty, forty, fifty]" {
+		t.Errorf("test: values want %v got %v", "[ten, twenty, thirty, forty, fifty]", values)
+	}
+	if s := table.String(); s != "{[[10, ten]], [[20, twenty]], [[30, thirty]], [[40, forty]], [[50, fifty]]}" {
+		t.Errorf("test: string got %v", s)
+	}
+	if !table.ContainsValue("forty") || table.ContainsValue("sixty") {
+		t.Errorf("test: contains value got wrong result")
+	}
+
+	reversed := NewTreeMapWithComparator(ReverseOrder(strings.Compare), NewEntry("a", 1), NewEntry("b", 2))
+	if keys := keysOf(reversed.Keys()); !reflect.DeepEqual(keys, []string{"b", "a"}) {
+		t.Errorf("test: reversed keys want %v got %v", []string{"b", "a"}, keys)
+	}
+}
+
+func TestTreeMap_FirstLastKey(t *testing.T) {
+	useCases := []struct {
+		description string
+		table       *TreeMap[int, string]
+		first       int
+		last        int
+		err         error
+	}{
+		{description: "empty tree map", table: NewTreeMap[int, string](), err: ErrEmptyCollection},
+		{description: "tree map with entries", table: newTestTreeMap(), first: 10, last: 50},
+	}
+
+	for _, tt := range useCases {
+		first, err1 := tt.table.FirstKey()
+		last, err2 := tt.table.LastKey()
+		if first != tt.first || last != tt.last || err1 != tt.err || err2 != tt.err {
+			t.Errorf("test: %s want {%v, %v, %v} got {%v, %v, %v}", tt.description, tt.first, tt.last, tt.err, first, last, err1)
+		}
+	}
+}
+
+func TestTreeMap_Navigation(t *testing.T) {
+	table := newTestTreeMap()
+	useCases := []struct {
+		description string
+		find        func(key int) (*Entry[int, string], bool)
+		key         int
+		want        int
+		found       bool
+	}{
+		{description: "floor of existing key", find: table.Floor, key: 20, want: 20, found: true},
+		{description: "floor between keys", find: table.Floor, key: 25, want: 20, found: true},
+		{description: "floor below first key", find: table.Floor, key: 5, found: false},
+		{description: "ceiling of existing key", find: table.Ceiling, key: 20, want: 20, found: true},
+		{description: "ceiling between keys", find: table.Ceiling, key: 25, want: 30, found: true},
+		{description: "ceiling above last key", find: table.Ceiling, key: 55, found: false},
+		{description: "lower of existing key", find: table.Lower, key: 20, want: 10, found: true},
+		{description: "lower of first key", find: table.Lower, key: 10, found: false},
+		{description: "higher of existing key", find: table.Higher, key: 20, want: 30, found: true},
+		{description: "higher of last key", find: table.Higher, key: 50, found: false},
+	}
+
+	for _, tt := range useCases {
+		entry, ok := tt.find(tt.key)
+		if ok != tt.found || (ok && entry.Key() != tt.want) {
+			t.Errorf("test: %s want {%v, %v} got {%v, %v}", tt.description, tt.want, tt.found, entry, ok)
+		}
+	}
+}
+
+func TestTreeMap_Ranges(t *testing.T) {
+	table := newTestTreeMap()
+	useCases := []struct {
+		description string
+		table       *TreeMap[int, string]
+		want        []int
+	}{
+		{description: "head map", table: table.HeadMap(30), want: []int{10, 20}},
+		{description: "tail map", table: table.TailMap(30), want: []int{30, 40, 50}},
+		{description: "sub map", table: table.SubMap(15, 45), want: []int{20, 30, 40}},
+		{description: "empty sub map", table: table.SubMap(41, 45), want: nil},
+		{description: "view of view keeps narrowest range", table: table.SubMap(15, 45).HeadMap(50).TailMap(10), want: []int{20, 30, 40}},
+	}
+
+	for _, tt := range useCases {
+		result := keysOf(tt.table.Keys())
+		if !reflect.DeepEqual(result, tt.want) {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.want, result)
+		}
+	}
+}
+
+func TestTreeMap_RangesExtremeComparator(t *testing.T) {
+	table := NewTreeMapWithComparator[int, int](extremeOrder)
+	for i := 0; i < 10; i++ {
+		table.Put(i, i)
+	}
+	useCases := []struct {
+		description string
+		table       *TreeMap[int, int]
+		want        int
+	}{
+		{description: "head map of head map", table: table.HeadMap(8).HeadMap(3), want: 3},
+		{description: "tail map of tail map", table: table.TailMap(2).TailMap(6), want: 4},
+		{description: "sub map of sub map", table: table.SubMap(1, 9).SubMap(3, 5), want: 2},
+		{description: "wider view of a view", table: table.HeadMap(3).HeadMap(8), want: 3},
+	}
+
+	for _, tt := range useCases {
+		if result := tt.table.Size(); result != tt.want {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.want, result)
+		}
+	}
+}
+
+func TestTreeMap_RangeViews(t *testing.T) {
+	table := newTestTreeMap()
+	view := table.SubMap(15, 45)
+	view.Put(60, "sixty")
+	view.Put(25, "twenty-five")
+	table.Delete(20)
+	table.Put(35, "thirty-five")
+	if result := keysOf(view.Keys()); !reflect.DeepEqual(result, []int{25, 30, 35, 40}) || view.Size() != 4 || table.Size() != 6 || table.ContainsKey(60) {
+		t.Errorf("test: write through view want %v got %v", []int{25, 30, 35, 40}, result)
+	}
+
+	useCases := []struct {
+		description string
+		find        func(key int) (*Entry[int, string], bool)
+		key         int
+		want        int
+		found       bool
+	}{
+		{description: "floor above the range", find: view.Floor, key: 50, want: 40, found: true},
+		{description: "ceiling below the range", find: view.Ceiling, key: 10, want: 25, found: true},
+		{description: "lower of the lowest key", find: view.Lower, key: 25, found: false},
+		{description: "higher of the highest key", find: view.Higher, key: 40, found: false},
+	}
+
+	for _, tt := range useCases {
+		entry, ok := tt.find(tt.key)
+		if ok != tt.found || (ok && entry.Key() != tt.want) {
+			t.Errorf("test: %s want {%v, %v} got {%v, %v}", tt.description, tt.want, tt.found, entry, ok)
+		}
+	}
+
+	if v, ok := view.Get(10); ok || view.Delete(10) || !table.ContainsKey(10) {
+		t.Errorf("test: key out of range want %v got %v", false, v)
+	}
+	view.Clear()
+	if result := keysOf(table.Keys()); !reflect.DeepEqual(result, []int{10, 50}) || !view.Empty() {
+		t.Errorf("test: clear view want %v got %v", []int{10, 50}, result)
+	}
+}
@@ -0,0 +1,256 @@
+package collection
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// TreeSet is a Set backed by a red-black tree, items are kept sorted by the
+// comparator given at construction time.
+// A TreeSet returned by SubSet, HeadSet or TailSet is a view that shares the
+// tree with the set it comes from, so changes made through either are visible
+// in both.
+type TreeSet[E comparable] struct {
+	tree *rbTree[E, struct{}]
+	lo   *bound[E]
+	hi   *bound[E]
+}
+
+// NewTreeSet creates a TreeSet ordered by the natural order of the items.
+func NewTreeSet[E cmp.Ordered](items ...E) *TreeSet[E] {
+	return NewTreeSetWithComparator(NaturalOrder[E](), items...)
+}
+
+// NewTreeSetWithComparator creates a TreeSet ordered by compare.
+func NewTreeSetWithComparator[E comparable](compare Comparator[E], items ...E) *TreeSet[E] {
+	set := &TreeSet[E]{tree: newRBTree[E, struct{}](compare)}
+	for _, item := range items {
+		set.Push(item)
+	}
+	return set
+}
+
+func (s *TreeSet[E]) tooLow(item E) bool {
+	return s.tree.below(item, s.lo)
+}
+
+func (s *TreeSet[E]) tooHigh(item E) bool {
+	return s.tree.above(item, s.hi)
+}
+
+func (s *TreeSet[E]) inRange(item E) bool {
+	return !s.tooLow(item) && !s.tooHigh(item)
+}
+
+// lowest returns the first node inside the range of the set.
+func (s *TreeSet[E]) lowest() *rbNode[E, struct{}] {
+	var n *rbNode[E, struct{}]
+	if s.lo == nil {
+		n = s.tree.first()
+	} else {
+		n = s.tree.ceiling(s.lo.value, s.lo.inclusive)
+	}
+	if n == nil || s.tooHigh(n.key) {
+		return nil
+	}
+	return n
+}
+
+// highest returns the last node inside the range of the set.
+func (s *TreeSet[E]) highest() *rbNode[E, struct{}] {
+	var n *rbNode[E, struct{}]
+	if s.hi == nil {
+		n = s.tree.last()
+	} else {
+		n = s.tree.floor(s.hi.value, s.hi.inclusive)
+	}
+	if n == nil || s.tooLow(n.key) {
+		return nil
+	}
+	return n
+}
+
+func (s *TreeSet[E]) Iterator() Iterator[E] {
+	first, last := s.lowest(), s.highest()
+	if first == nil || last == nil {
+		return &emptyListIterator[E]{}
+	}
+	return newTreeIterator(s.tree, first, successor(last), false, nodeKey[E, struct{}])
+}
+
+// DescendingIterator returns an iterator over the items of the set in descending order.
+func (s *TreeSet[E]) DescendingIterator() Iterator[E] {
+	first, last := s.lowest(), s.highest()
+	if first == nil || last == nil {
+		return &emptyListIterator[E]{}
+	}
+	return newTreeIterator(s.tree, last, predecessor(first), true, nodeKey[E, struct{}])
+}
+
+// All returns a sequence over the items of the set in ascending order.
+func (s *TreeSet[E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for it := s.Iterator(); it.HasNext(); {
+			if !yield(it.Next()) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate returns a sequence over the items of the set in ascending order along with their position.
+func (s *TreeSet[E]) Enumerate() iter.Seq2[int, E] {
+	return enumerate(s.All())
+}
+
+func nodeKey[K any, V any](n *rbNode[K, V]) K {
+	return n.key
+}
+
+func (s *TreeSet[E]) Empty() bool {
+	return s.lowest() == nil
+}
+
+// Size returns the number of items in the set, on views it takes linear time.
+func (s *TreeSet[E]) Size() int {
+	if s.lo == nil && s.hi == nil {
+		return s.tree.size
+	}
+	size := 0
+	for it := s.Iterator(); it.HasNext(); it.Next() {
+		size++
+	}
+	return size
+}
+
+// Push adds an item to the set, on views items out of range are ignored.
+func (s *TreeSet[E]) Push(item E) {
+	if s.inRange(item) {
+		s.tree.put(item, struct{}{})
+	}
+}
+
+func (s *TreeSet[E]) Contains(item E) bool {
+	return s.inRange(item) && s.tree.find(item) != nil
+}
+
+func (s *TreeSet[E]) Delete(item E) error {
+	if s.inRange(item) {
+		s.tree.remove(item)
+	}
+	return nil
+}
+
+// First returns the lowest item of the set, but
+// if set is empty well this method return ErrEmptyCollection error.
+func (s *TreeSet[E]) First() (E, error) {
+	n := s.lowest()
+	if n == nil {
+		return *new(E), ErrEmptyCollection
+	}
+	return n.key, nil
+}
+
+// Last returns the highest item of the set, but
+// if set is empty well this method return ErrEmptyCollection error.
+func (s *TreeSet[E]) Last() (E, error) {
+	n := s.highest()
+	if n == nil {
+		return *new(E), ErrEmptyCollection
+	}
+	return n.key, nil
+}
+
+// PollFirst gets and removes the lowest item of the set, but
+// if set is empty well this method return ErrEmptyCollection error.
+func (s *TreeSet[E]) PollFirst() (E, error) {
+	n := s.lowest()
+	if n == nil {
+		return *new(E), ErrEmptyCollection
+	}
+	item := n.key
+	s.tree.deleteNode(n)
+	return item, nil
+}
+
+// PollLast gets and removes the highest item of the set, but
+// if set is empty well this method return ErrEmptyCollection error.
+func (s *TreeSet[E]) PollLast() (E, error) {
+	n := s.highest()
+	if n == nil {
+		return *new(E), ErrEmptyCollection
+	}
+	item := n.key
+	s.tree.deleteNode(n)
+	return item, nil
+}
+
+// Floor returns the greatest item less than or equal to item.
+func (s *TreeSet[E]) Floor(item E) (E, bool) {
+	return s.nodeInRange(s.tree.floor(item, true))
+}
+
+// Ceiling returns the least item greater than or equal to item.
+func (s *TreeSet[E]) Ceiling(item E) (E, bool) {
+	return s.nodeInRange(s.tree.ceiling(item, true))
+}
+
+// Lower returns the greatest item strictly less than item.
+func (s *TreeSet[E]) Lower(item E) (E, bool) {
+	return s.nodeInRange(s.tree.floor(item, false))
+}
+
+// Higher returns the least item strictly greater than item.
+func (s *TreeSet[E]) Higher(item E) (E, bool) {
+	return s.nodeInRange(s.tree.ceiling(item, false))
+}
+
+func (s *TreeSet[E]) nodeInRange(n *rbNode[E, struct{}]) (E, bool) {
+	if n == nil || !s.inRange(n.key) {
+		return *new(E), false
+	}
+	return n.key, true
+}
+
+// SubSet returns a view of the items ranging from from to to, each end is
+// included in the range when the matching inclusive flag is true.
+func (s *TreeSet[E]) SubSet(from E, fromInclusive bool, to E, toInclusive bool) *TreeSet[E] {
+	return s.view(&bound[E]{from, fromInclusive}, &bound[E]{to, toInclusive})
+}
+
+// HeadSet returns a view of the items less than to, or equal to it when inclusive is true.
+func (s *TreeSet[E]) HeadSet(to E, inclusive bool) *TreeSet[E] {
+	return s.view(nil, &bound[E]{to, inclusive})
+}
+
+// TailSet returns a view of the items greater than from, or equal to it when inclusive is true.
+func (s *TreeSet[E]) TailSet(from E, inclusive bool) *TreeSet[E] {
+	return s.view(&bound[E]{from, inclusive}, nil)
+}
+
+// view creates a view on the same tree whose range is the intersection of
+// the range of s and the given bounds.
+func (s *TreeSet[E]) view(lo, hi *bound[E]) *TreeSet[E] {
+	v := &TreeSet[E]{tree: s.tree}
+	v.lo, v.hi = s.tree.narrow(s.lo, s.hi, lo, hi)
+	return v
+}
+
+func (s *TreeSet[E]) String() string {
+	var sb strings.Builder
+	sb.WriteString("[")
+	for it := s.Iterator(); it.HasNext(); {
+		var str string
+		i, item := it.NextWithIndex()
+		if i > 0 {
+			str = fmt.Sprintf(", %v", item)
+		} else {
+			str = fmt.Sprintf("%v", item)
+		}
+		sb.WriteString(str)
+	}
+	sb.WriteString("]")
+	return sb.String()
+}
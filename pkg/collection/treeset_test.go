@@ -0,0 +1,187 @@
+package collection
+
+import (
+	"reflect"
+	"testing"
+)
+
+func itemsOf[E any](it Iterator[E]) []E {
+	var items []E
+	for it.HasNext() {
+		items = append(items, it.Next())
+	}
+	return items
+}
+
+func TestTreeSet_Size(t *testing.T) {
+	useCases := []struct {
+		description string
+		set         *TreeSet[int]
+		want        int
+	}{
+		{description: "empty set", set: NewTreeSet[int](), want: 0},
+		{description: "set with items", set: NewTreeSet(3, 1, 2), want: 3},
+		{description: "set with duplicated items", set: NewTreeSet(1, 1, 2), want: 2},
+		{description: "view of set", set: NewTreeSet(1, 2, 3, 4).SubSet(2, true, 4, false), want: 2},
+	}
+
+	for _, tt := range useCases {
+		result := tt.set.Size()
+		if result != tt.want || tt.set.Empty() != (tt.want == 0) {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.want, result)
+		}
+	}
+}
+
+func TestTreeSet_Iterator(t *testing.T) {
+	useCases := []struct {
+		description string
+		it          Iterator[int]
+		want        []int
+	}{
+		{description: "ascending order", it: NewTreeSet(5, 3, 1, 4, 2).Iterator(), want: []int{1, 2, 3, 4, 5}},
+		{description: "descending order", it: NewTreeSet(5, 3, 1, 4, 2).DescendingIterator(), want: []int{5, 4, 3, 2, 1}},
+		{description: "empty set", it: NewTreeSet[int]().Iterator(), want: nil},
+		{description: "descending order of view", it: NewTreeSet(5, 3, 1, 4, 2).HeadSet(3, true).DescendingIterator(), want: []int{3, 2, 1}},
+	}
+
+	for _, tt := range useCases {
+		result := itemsOf(tt.it)
+		if !reflect.DeepEqual(result, tt.want) {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.want, result)
+		}
+	}
+}
+
+func TestTreeSet_Delete(t *testing.T) {
+	useCases := []struct {
+		description string
+		original    *TreeSet[int]
+		item        int
+		want        string
+	}{
+		{description: "delete existing item", original: NewTreeSet(1, 2, 3), item: 2, want: "[1, 3]"},
+		{description: "delete missing item", original: NewTreeSet(1, 2, 3), item: 4, want: "[1, 2, 3]"},
+	}
+
+	for _, tt := range useCases {
+		err := tt.original.Delete(tt.item)
+		if tt.original.String() != tt.want || err != nil || tt.original.Contains(tt.item) {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.want, tt.original)
+		}
+	}
+}
+
+func TestTreeSet_FirstLast(t *testing.T) {
+	useCases := []struct {
+		description string
+		set         *TreeSet[int]
+		first       int
+		last        int
+		err         error
+	}{
+		{description: "empty set", set: NewTreeSet[int](), err: ErrEmptyCollection},
+		{description: "set with items", set: NewTreeSet(2, 3, 1), first: 1, last: 3},
+		{description: "empty view", set: NewTreeSet(1, 5).SubSet(2, true, 4, true), err: ErrEmptyCollection},
+	}
+
+	for _, tt := range useCases {
+		first, err1 := tt.set.First()
+		last, err2 := tt.set.Last()
+		if first != tt.first || last != tt.last || err1 != tt.err || err2 != tt.err {
+			t.Errorf("test: %s want {%v, %v, %v} got {%v, %v, %v}", tt.description, tt.first, tt.last, tt.err, first, last, err1)
+		}
+	}
+}
+
+func TestTreeSet_Poll(t *testing.T) {
+	set := NewTreeSet(1, 2, 3, 4)
+	first, err1 := set.PollFirst()
+	last, err2 := set.PollLast()
+	if first != 1 || last != 4 || err1 != nil || err2 != nil || set.String() != "[2, 3]" {
+		t.Errorf("test: poll want {%v, %v, %v} got {%v, %v, %v}", 1, 4, "[2, 3]", first, last, set)
+	}
+
+	empty := NewTreeSet[int]()
+	if _, err := empty.PollFirst(); err != ErrEmptyCollection {
+		t.Errorf("test: poll first on empty set want %v got %v", ErrEmptyCollection, err)
+	}
+	if _, err := empty.PollLast(); err != ErrEmptyCollection {
+		t.Errorf("test: poll last on empty set want %v got %v", ErrEmptyCollection, err)
+	}
+}
+
+func TestTreeSet_Navigation(t *testing.T) {
+	set := NewTreeSet(10, 20, 30)
+	useCases := []struct {
+		description string
+		find        func(item int) (int, bool)
+		item        int
+		want        int
+		found       bool
+	}{
+		{description: "floor between items", find: set.Floor, item: 25, want: 20, found: true},
+		{description: "floor below first item", find: set.Floor, item: 5, found: false},
+		{description: "ceiling between items", find: set.Ceiling, item: 25, want: 30, found: true},
+		{description: "ceiling of existing item", find: set.Ceiling, item: 20, want: 20, found: true},
+		{description: "lower of existing item", find: set.Lower, item: 20, want: 10, found: true},
+		{description: "higher of last item", find: set.Higher, item: 30, found: false},
+		{description: "floor outside view", find: set.TailSet(20, true).Floor, item: 15, found: false},
+	}
+
+	for _, tt := range useCases {
+		result, ok := tt.find(tt.item)
+		if result != tt.want || ok != tt.found {
+			t.Errorf("test: %s want {%v, %v} got {%v, %v}", tt.description, tt.want, tt.found, result, ok)
+		}
+	}
+}
+
+func TestTreeSet_Views(t *testing.T) {
+	set := NewTreeSet(1, 2, 3, 4, 5, 6)
+	useCases := []struct {
+		description string
+		view        *TreeSet[int]
+		want        string
+	}{
+		{description: "inclusive sub set", view: set.SubSet(2, true, 5, true), want: "[2, 3, 4, 5]"},
+		{description: "exclusive sub set", view: set.SubSet(2, false, 5, false), want: "[3, 4]"},
+		{description: "inclusive head set", view: set.HeadSet(3, true), want: "[1, 2, 3]"},
+		{description: "exclusive head set", view: set.HeadSet(3, false), want: "[1, 2]"},
+		{description: "inclusive tail set", view: set.TailSet(4, true), want: "[4, 5, 6]"},
+		{description: "exclusive tail set", view: set.TailSet(4, false), want: "[5, 6]"},
+		{description: "view of view keeps narrowest range", view: set.SubSet(2, true, 5, true).SubSet(0, true, 4, false), want: "[2, 3]"},
+	}
+
+	for _, tt := range useCases {
+		if tt.view.String() != tt.want {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.want, tt.view)
+		}
+	}
+
+	extreme := NewTreeSetWithComparator(extremeOrder, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9)
+	extremeCases := []struct {
+		description string
+		view        *TreeSet[int]
+		want        string
+	}{
+		{description: "head set of head set", view: extreme.HeadSet(8, false).HeadSet(3, false), want: "[0, 1, 2]"},
+		{description: "inclusive head set of exclusive head set", view: extreme.HeadSet(3, false).HeadSet(3, true), want: "[0, 1, 2]"},
+		{description: "tail set of tail set", view: extreme.TailSet(2, true).TailSet(6, true), want: "[6, 7, 8, 9]"},
+		{description: "sub set of sub set", view: extreme.SubSet(1, true, 9, true).SubSet(3, true, 5, true), want: "[3, 4, 5]"},
+	}
+	for _, tt := range extremeCases {
+		if tt.view.String() != tt.want {
+			t.Errorf("test: extreme comparator %s want %v got %v", tt.description, tt.want, tt.view)
+		}
+	}
+
+	view := set.SubSet(2, true, 4, true)
+	view.Push(10)
+	view.Push(3)
+	set.Delete(2)
+	set.Push(7)
+	if view.String() != "[3, 4]" || set.String() != "[1, 3, 4, 5, 6, 7]" || view.Contains(10) {
+		t.Errorf("test: write through view want {%v, %v} got {%v, %v}", "[3, 4]", "[1, 3, 4, 5, 6, 7]", view, set)
+	}
+}
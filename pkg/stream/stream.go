@@ -0,0 +1,356 @@
+// Package stream provides lazy pipelines over the iterators of the collection package.
+//
+// Intermediate operations such as Filter or Map only describe the pipeline,
+// nothing is pulled from the source until a terminal operation such as
+// Reduce, AnyMatch or ToSlice runs. A stream can be consumed only once.
+package stream
+
+import "github.com/asd/pkg/collection"
+
+// Stream is a lazy sequence of items.
+type Stream[E any] struct {
+	next func() (E, bool)
+}
+
+// Pair holds the items zipped together by Zip.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// Of creates a stream over the items of an iterable.
+func Of[E any](items collection.Iterable[E]) *Stream[E] {
+	var it collection.Iterator[E]
+	return &Stream[E]{next: func() (E, bool) {
+		// the iterator is created on the first pull so that building
+		// the pipeline does not touch the source
+		if it == nil {
+			it = items.Iterator()
+		}
+		return pull(it)
+	}}
+}
+
+// FromIterator creates a stream that pulls its items from it.
+func FromIterator[E any](it collection.Iterator[E]) *Stream[E] {
+	return &Stream[E]{next: func() (E, bool) {
+		return pull(it)
+	}}
+}
+
+// Values creates a stream over the given items.
+func Values[E any](items ...E) *Stream[E] {
+	i := 0
+	return &Stream[E]{next: func() (E, bool) {
+		if i >= len(items) {
+			return *new(E), false
+		}
+		i++
+		return items[i-1], true
+	}}
+}
+
+func pull[E any](it collection.Iterator[E]) (E, bool) {
+	if !it.HasNext() {
+		return *new(E), false
+	}
+	return it.Next(), true
+}
+
+// Iterator returns an iterator over the remaining items of the stream,
+// so a stream can be used wherever an Iterable is expected.
+func (s *Stream[E]) Iterator() collection.Iterator[E] {
+	return &streamIterator[E]{stream: s}
+}
+
+// Filter keeps the items matching pred.
+func (s *Stream[E]) Filter(pred func(item E) bool) *Stream[E] {
+	return &Stream[E]{next: func() (E, bool) {
+		for {
+			item, ok := s.next()
+			if !ok || pred(item) {
+				return item, ok
+			}
+		}
+	}}
+}
+
+// TakeWhile keeps the items up to the first one not matching pred.
+func (s *Stream[E]) TakeWhile(pred func(item E) bool) *Stream[E] {
+	done := false
+	return &Stream[E]{next: func() (E, bool) {
+		if done {
+			return *new(E), false
+		}
+		item, ok := s.next()
+		if !ok || !pred(item) {
+			done = true
+			return *new(E), false
+		}
+		return item, true
+	}}
+}
+
+// DropWhile discards the items up to the first one not matching pred.
+func (s *Stream[E]) DropWhile(pred func(item E) bool) *Stream[E] {
+	dropping := true
+	return &Stream[E]{next: func() (E, bool) {
+		for {
+			item, ok := s.next()
+			if !ok || !dropping || !pred(item) {
+				dropping = false
+				return item, ok
+			}
+		}
+	}}
+}
+
+// Limit keeps at most n items.
+func (s *Stream[E]) Limit(n int) *Stream[E] {
+	taken := 0
+	return &Stream[E]{next: func() (E, bool) {
+		if taken >= n {
+			return *new(E), false
+		}
+		taken++
+		return s.next()
+	}}
+}
+
+// Skip discards the first n items.
+func (s *Stream[E]) Skip(n int) *Stream[E] {
+	skipped := 0
+	return &Stream[E]{next: func() (E, bool) {
+		for ; skipped < n; skipped++ {
+			if _, ok := s.next(); !ok {
+				return *new(E), false
+			}
+		}
+		return s.next()
+	}}
+}
+
+// Reduce combines the items with fn, it returns false when the stream is empty.
+func (s *Stream[E]) Reduce(fn func(acc E, item E) E) (E, bool) {
+	acc, ok := s.next()
+	if !ok {
+		return acc, false
+	}
+	for item, ok := s.next(); ok; item, ok = s.next() {
+		acc = fn(acc, item)
+	}
+	return acc, true
+}
+
+// AnyMatch reports whether at least one item matches pred, it stops at the first match.
+func (s *Stream[E]) AnyMatch(pred func(item E) bool) bool {
+	for item, ok := s.next(); ok; item, ok = s.next() {
+		if pred(item) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllMatch reports whether every item matches pred, it stops at the first mismatch.
+func (s *Stream[E]) AllMatch(pred func(item E) bool) bool {
+	return !s.AnyMatch(func(item E) bool {
+		return !pred(item)
+	})
+}
+
+// Partition splits the items into the ones matching pred and the others.
+func (s *Stream[E]) Partition(pred func(item E) bool) (*collection.Slice[E], *collection.Slice[E]) {
+	matching, others := collection.NewSlice[E](), collection.NewSlice[E]()
+	for item, ok := s.next(); ok; item, ok = s.next() {
+		if pred(item) {
+			matching.Push(item)
+		} else {
+			others.Push(item)
+		}
+	}
+	return matching, others
+}
+
+// ToSlice collects the items into a new Slice.
+func (s *Stream[E]) ToSlice() *collection.Slice[E] {
+	return Collect[E](s, collection.NewSlice[E]())
+}
+
+// ToLinkedList collects the items into a new LinkedList.
+func (s *Stream[E]) ToLinkedList() *collection.LinkedList[E] {
+	return Collect[E](s, collection.NewLinkedList[E]())
+}
+
+// Map transforms every item with fn.
+func Map[E any, R any](s *Stream[E], fn func(item E) R) *Stream[R] {
+	return &Stream[R]{next: func() (R, bool) {
+		item, ok := s.next()
+		if !ok {
+			return *new(R), false
+		}
+		return fn(item), true
+	}}
+}
+
+// FlatMap transforms every item into an iterable and concatenates their items.
+func FlatMap[E any, R any](s *Stream[E], fn func(item E) collection.Iterable[R]) *Stream[R] {
+	var current collection.Iterator[R]
+	return &Stream[R]{next: func() (R, bool) {
+		for current == nil || !current.HasNext() {
+			item, ok := s.next()
+			if !ok {
+				return *new(R), false
+			}
+			current = fn(item).Iterator()
+		}
+		return current.Next(), true
+	}}
+}
+
+// Distinct discards the items already seen.
+func Distinct[E comparable](s *Stream[E]) *Stream[E] {
+	seen := collection.NewHashSet[E]()
+	return s.Filter(func(item E) bool {
+		if seen.Contains(item) {
+			return false
+		}
+		seen.Push(item)
+		return true
+	})
+}
+
+// Zip pairs the items of a and b, it stops when the shorter stream ends.
+func Zip[A any, B any](a *Stream[A], b *Stream[B]) *Stream[Pair[A, B]] {
+	return &Stream[Pair[A, B]]{next: func() (Pair[A, B], bool) {
+		first, ok := a.next()
+		if !ok {
+			return Pair[A, B]{}, false
+		}
+		second, ok := b.next()
+		if !ok {
+			return Pair[A, B]{}, false
+		}
+		return Pair[A, B]{first, second}, true
+	}}
+}
+
+// Chunk groups the items in consecutive slices of size items, the last one
+// may be shorter. A size lower than one gives an empty stream.
+func Chunk[E any](s *Stream[E], size int) *Stream[[]E] {
+	if size < 1 {
+		return Values[[]E]()
+	}
+	return &Stream[[]E]{next: func() ([]E, bool) {
+		chunk := make([]E, 0, size)
+		for len(chunk) < size {
+			item, ok := s.next()
+			if !ok {
+				break
+			}
+			chunk = append(chunk, item)
+		}
+		return chunk, len(chunk) > 0
+	}}
+}
+
+// Window returns the sliding windows of size consecutive items, moving by
+// one item at a time. A size lower than one gives an empty stream.
+func Window[E any](s *Stream[E], size int) *Stream[[]E] {
+	if size < 1 {
+		return Values[[]E]()
+	}
+	var window []E
+	return &Stream[[]E]{next: func() ([]E, bool) {
+		if len(window) == size {
+			window = window[1:]
+		}
+		for len(window) < size {
+			item, ok := s.next()
+			if !ok {
+				return nil, false
+			}
+			window = append(window, item)
+		}
+		return append([]E(nil), window...), true
+	}}
+}
+
+// Fold combines the items with fn starting from init.
+func Fold[E any, R any](s *Stream[E], init R, fn func(acc R, item E) R) R {
+	acc := init
+	for item, ok := s.next(); ok; item, ok = s.next() {
+		acc = fn(acc, item)
+	}
+	return acc
+}
+
+// GroupBy collects the items into slices indexed by the key computed by key.
+func GroupBy[E any, K comparable](s *Stream[E], key func(item E) K) *collection.HashMap[K, *collection.Slice[E]] {
+	groups := collection.NewHashMap[K, *collection.Slice[E]]()
+	for item, ok := s.next(); ok; item, ok = s.next() {
+		k := key(item)
+		group, found := groups.Get(k)
+		if !found {
+			group = collection.NewSlice[E]()
+			groups.Put(k, group)
+		}
+		group.Push(item)
+	}
+	return groups
+}
+
+// Collect pushes the items into c and returns it.
+func Collect[E any, C collection.Collection[E]](s *Stream[E], c C) C {
+	for item, ok := s.next(); ok; item, ok = s.next() {
+		c.Push(item)
+	}
+	return c
+}
+
+// ToHashSet collects the items into a new HashSet.
+func ToHashSet[E comparable](s *Stream[E]) *collection.HashSet[E] {
+	return Collect[E](s, collection.NewHashSet[E]())
+}
+
+// ToHashMap collects the items into a new HashMap, later items replace the
+// values of earlier ones with the same key.
+func ToHashMap[E any, K comparable, V any](s *Stream[E], key func(item E) K, value func(item E) V) *collection.HashMap[K, V] {
+	m := collection.NewHashMap[K, V]()
+	for item, ok := s.next(); ok; item, ok = s.next() {
+		m.Put(key(item), value(item))
+	}
+	return m
+}
+
+// streamIterator adapts a stream to the Iterator interface looking one item ahead.
+type streamIterator[E any] struct {
+	stream  *Stream[E]
+	item    E
+	fetched bool
+	ok      bool
+	index   int
+}
+
+func (it *streamIterator[E]) HasNext() bool {
+	if !it.fetched {
+		it.item, it.ok = it.stream.next()
+		it.fetched = true
+	}
+	return it.ok
+}
+
+func (it *streamIterator[E]) Next() E {
+	_, item := it.NextWithIndex()
+	return item
+}
+
+func (it *streamIterator[E]) NextWithIndex() (int, E) {
+	if !it.HasNext() {
+		return it.index, *new(E)
+	}
+	it.fetched = false
+	index := it.index
+	it.index++
+	return index, it.item
+}
@@ -0,0 +1,143 @@
+package stream
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/asd/pkg/collection"
+)
+
+func toSlice[E any](s *Stream[E]) []E {
+	var items []E
+	for it := s.Iterator(); it.HasNext(); {
+		items = append(items, it.Next())
+	}
+	return items
+}
+
+func TestStream_Intermediate(t *testing.T) {
+	isEven := func(item int) bool { return item%2 == 0 }
+	lessThan := func(n int) func(int) bool { return func(item int) bool { return item < n } }
+	useCases := []struct {
+		description string
+		stream      *Stream[int]
+		want        []int
+	}{
+		{description: "of slice", stream: Of[int](collection.NewSlice(1, 2, 3)), want: []int{1, 2, 3}},
+		{description: "of linked list", stream: Of[int](collection.NewLinkedList(1, 2, 3)), want: []int{1, 2, 3}},
+		{description: "filter", stream: Values(1, 2, 3, 4).Filter(isEven), want: []int{2, 4}},
+		{description: "take while", stream: Values(1, 2, 3, 1).TakeWhile(lessThan(3)), want: []int{1, 2}},
+		{description: "drop while", stream: Values(1, 2, 3, 1).DropWhile(lessThan(3)), want: []int{3, 1}},
+		{description: "limit", stream: Values(1, 2, 3).Limit(2), want: []int{1, 2}},
+		{description: "limit over size", stream: Values(1, 2, 3).Limit(5), want: []int{1, 2, 3}},
+		{description: "skip", stream: Values(1, 2, 3).Skip(2), want: []int{3}},
+		{description: "skip over size", stream: Values(1, 2, 3).Skip(5), want: nil},
+		{description: "distinct", stream: Distinct(Values(1, 2, 1, 3, 2)), want: []int{1, 2, 3}},
+		{description: "map", stream: Map(Values(1, 2, 3), func(item int) int { return item * 10 }), want: []int{10, 20, 30}},
+		{description: "flat map", stream: FlatMap(Values(1, 2, 3), func(item int) collection.Iterable[int] {
+			return collection.NewSlice(make([]int, item)...)
+		}), want: []int{0, 0, 0, 0, 0, 0}},
+	}
+
+	for _, tt := range useCases {
+		result := toSlice(tt.stream)
+		if !reflect.DeepEqual(result, tt.want) {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.want, result)
+		}
+	}
+}
+
+func TestStream_Lazy(t *testing.T) {
+	pulled := 0
+	s := Map(Values(1, 2, 3, 4, 5), func(item int) int {
+		pulled++
+		return item
+	}).Filter(func(item int) bool { return item > 1 }).Limit(2)
+	if pulled != 0 {
+		t.Errorf("test: nothing pulled before terminal operation want %v got %v", 0, pulled)
+	}
+	if result := s.ToSlice().String(); result != "[2, 3]" || pulled != 3 {
+		t.Errorf("test: pull only what is needed want {%v, %v} got {%v, %v}", "[2, 3]", 3, result, pulled)
+	}
+}
+
+func TestStream_Groups(t *testing.T) {
+	zipped := toSlice(Zip(Values(1, 2, 3), Values("a", "b")))
+	if want := []Pair[int, string]{{1, "a"}, {2, "b"}}; !reflect.DeepEqual(zipped, want) {
+		t.Errorf("test: zip want %v got %v", want, zipped)
+	}
+
+	useCases := []struct {
+		description string
+		stream      *Stream[[]int]
+		want        [][]int
+	}{
+		{description: "chunk", stream: Chunk(Values(1, 2, 3, 4, 5), 2), want: [][]int{{1, 2}, {3, 4}, {5}}},
+		{description: "chunk of invalid size", stream: Chunk(Values(1, 2), 0), want: nil},
+		{description: "window", stream: Window(Values(1, 2, 3, 4), 3), want: [][]int{{1, 2, 3}, {2, 3, 4}}},
+		{description: "window bigger than stream", stream: Window(Values(1, 2), 3), want: nil},
+	}
+
+	for _, tt := range useCases {
+		result := toSlice(tt.stream)
+		if !reflect.DeepEqual(result, tt.want) {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.want, result)
+		}
+	}
+}
+
+func TestStream_Terminal(t *testing.T) {
+	sum := func(acc, item int) int { return acc + item }
+	if result, ok := Values(1, 2, 3).Reduce(sum); result != 6 || !ok {
+		t.Errorf("test: reduce want {%v, %v} got {%v, %v}", 6, true, result, ok)
+	}
+	if _, ok := Values[int]().Reduce(sum); ok {
+		t.Errorf("test: reduce empty stream want %v got %v", false, ok)
+	}
+	if result := Fold(Values(1, 2, 3), "", func(acc string, item int) string { return acc + string(rune('0'+item)) }); result != "123" {
+		t.Errorf("test: fold want %v got %v", "123", result)
+	}
+
+	isEven := func(item int) bool { return item%2 == 0 }
+	useCases := []struct {
+		description string
+		result      bool
+		want        bool
+	}{
+		{description: "any match", result: Values(1, 2, 3).AnyMatch(isEven), want: true},
+		{description: "any match on empty stream", result: Values[int]().AnyMatch(isEven), want: false},
+		{description: "all match", result: Values(2, 4).AllMatch(isEven), want: true},
+		{description: "not all match", result: Values(2, 3).AllMatch(isEven), want: false},
+	}
+	for _, tt := range useCases {
+		if tt.result != tt.want {
+			t.Errorf("test: %s want %v got %v", tt.description, tt.want, tt.result)
+		}
+	}
+
+	even, odd := Values(1, 2, 3, 4, 5).Partition(isEven)
+	if even.String() != "[2, 4]" || odd.String() != "[1, 3, 5]" {
+		t.Errorf("test: partition want {%v, %v} got {%v, %v}", "[2, 4]", "[1, 3, 5]", even, odd)
+	}
+
+	groups := GroupBy(Values("one", "two", "three"), func(item string) int { return len(item) })
+	if three, _ := groups.Get(3); groups.Size() != 2 || three.String() != "[one, two]" {
+		t.Errorf("test: group by want %v got %v", "[one, two]", three)
+	}
+}
+
+func TestStream_Collect(t *testing.T) {
+	if result := Values(1, 2, 3).ToLinkedList(); result.String() != "[1, 2, 3]" {
+		t.Errorf("test: to linked list want %v got %v", "[1, 2, 3]", result)
+	}
+	if result := ToHashSet(Values(1, 2, 1)); result.Size() != 2 || !result.Contains(1) || !result.Contains(2) {
+		t.Errorf("test: to hash set want %v got %v", "[1, 2]", result)
+	}
+	table := ToHashMap(Values("a", "bb"), func(item string) string { return item }, func(item string) int { return len(item) })
+	if v, _ := table.Get("bb"); table.Size() != 2 || v != 2 {
+		t.Errorf("test: to hash map want %v got %v", 2, v)
+	}
+	if result := Collect[int](Values(3, 1, 2), collection.NewTreeSet[int]()); result.String() != "[1, 2, 3]" {
+		t.Errorf("test: collect into tree set want %v got %v", "[1, 2, 3]", result)
+	}
+}